@@ -0,0 +1,226 @@
+package model
+
+import "fmt"
+
+/*
+BipartiteColoring computes a two-colouring of the graph.
+
+Parameters:
+- g: The undirected graph.
+
+Returns:
+- coloring: Maps every node to 0 or 1 so that adjacent nodes get different colours. In every connected component, the smallest node gets colour 0.
+- error: If the graph contains an odd cycle and therefore is not bipartite.
+
+Example:
+
+	coloring, _ := BipartiteColoring(PathGraph(3))
+	fmt.Println(coloring) // Output: map[0:0 1:1 2:0]
+*/
+func BipartiteColoring(g *UndirectedGraph) (map[Node]int, error) {
+	coloring := make(map[Node]int, len(g.Nodes))
+	for _, root := range sortedNodes(g.Nodes) {
+		if _, colored := coloring[root]; colored {
+			continue
+		}
+		coloring[root] = 0
+		queue := []Node{root}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			for _, neighbor := range g.Edges[node] {
+				color, colored := coloring[neighbor]
+				if !colored {
+					coloring[neighbor] = 1 - coloring[node]
+					queue = append(queue, neighbor)
+				} else if color == coloring[node] {
+					return nil, fmt.Errorf("graph is not bipartite: nodes %d and %d lie on an odd cycle", node, neighbor)
+				}
+			}
+		}
+	}
+	return coloring, nil
+}
+
+// IsBipartite reports whether the nodes of the graph can be split into two sets
+// such that every edge joins the two sets.
+func IsBipartite(g *UndirectedGraph) bool {
+	_, err := BipartiteColoring(g)
+	return err == nil
+}
+
+// IsBipartiteNodeSet reports whether nodes is one side of a valid bipartition of g,
+// i.e. no edge joins two nodes inside the set nor two nodes outside of it.
+func IsBipartiteNodeSet(g *UndirectedGraph, nodes map[Node]bool) bool {
+	for _, edge := range g.GetEdgeTuples() {
+		if nodes[edge.Node1] == nodes[edge.Node2] {
+			return false
+		}
+	}
+	return true
+}
+
+// BipartiteSets returns the two sides of a bipartite graph, following the
+// colouring of BipartiteColoring. For disconnected graphs the bipartition is
+// not unique; the top set always contains the smallest node of every component.
+func BipartiteSets(g *UndirectedGraph) (top map[Node]bool, bottom map[Node]bool, err error) {
+	coloring, err := BipartiteColoring(g)
+	if err != nil {
+		return nil, nil, err
+	}
+	top = make(map[Node]bool)
+	bottom = make(map[Node]bool)
+	for node, color := range coloring {
+		if color == 0 {
+			top[node] = true
+		} else {
+			bottom[node] = true
+		}
+	}
+	return top, bottom, nil
+}
+
+// projectionNeighbours returns, for every node of the projection set, the set
+// of its neighbours, i.e. nodes of the other side.
+func projectionNeighbours(g *UndirectedGraph, nodes map[Node]bool) map[Node]map[Node]bool {
+	neighbours := make(map[Node]map[Node]bool, len(nodes))
+	for node := range nodes {
+		if !g.HasNode(node) {
+			continue
+		}
+		neighbours[node] = make(map[Node]bool)
+		for _, neighbor := range g.Edges[node] {
+			neighbours[node][neighbor] = true
+		}
+	}
+	return neighbours
+}
+
+// sharedNeighbourCounts counts, for every pair u < v of the projection set,
+// the number of neighbours they have in common.
+func sharedNeighbourCounts(g *UndirectedGraph, nodes map[Node]bool) map[Edge]int {
+	counts := make(map[Edge]int)
+	for _, middle := range sortedNodes(g.Nodes) {
+		if nodes[middle] {
+			continue
+		}
+		var members []Node
+		seen := make(map[Node]bool)
+		for _, neighbor := range g.Edges[middle] {
+			if nodes[neighbor] && !seen[neighbor] {
+				seen[neighbor] = true
+				members = append(members, neighbor)
+			}
+		}
+		for i := 0; i < len(members); i++ {
+			for j := i + 1; j < len(members); j++ {
+				u, v := members[i], members[j]
+				if u > v {
+					u, v = v, u
+				}
+				counts[Edge{Node1: u, Node2: v}]++
+			}
+		}
+	}
+	return counts
+}
+
+func projectionFromCounts(g *UndirectedGraph, nodes map[Node]bool, counts map[Edge]int) *UndirectedGraph {
+	projection := &UndirectedGraph{
+		Nodes: make(map[Node]bool),
+		Edges: make(map[Node][]Node),
+	}
+	for node := range nodes {
+		if g.HasNode(node) {
+			projection.AddNode(node)
+		}
+	}
+	for edge := range counts {
+		projection.AddEdge(edge)
+	}
+	return projection
+}
+
+/*
+ProjectedGraph returns the projection of a bipartite graph onto one of its node sets.
+
+Parameters:
+- g: The bipartite graph.
+- nodes: The nodes to project onto.
+
+Description:
+Two nodes of the set are adjacent in the projection when they share at least one neighbour in g.
+
+Example:
+
+	// users 0 and 1 both rated item 10
+	g := UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 10}, {1, 10}, {2, 11}})
+	projection := ProjectedGraph(&g, map[Node]bool{0: true, 1: true, 2: true})
+	fmt.Println(projection.Edges) // Output: map[0:[1] 1:[0]]
+*/
+func ProjectedGraph(g *UndirectedGraph, nodes map[Node]bool) *UndirectedGraph {
+	return projectionFromCounts(g, nodes, sharedNeighbourCounts(g, nodes))
+}
+
+/*
+WeightedProjectedGraph returns the projection of a bipartite graph onto one of its node sets
+together with edge weights.
+
+Parameters:
+- g: The bipartite graph.
+- nodes: The nodes to project onto.
+- ratio: If false, the weight of u - v is the number of shared neighbours. If true, it is that number divided by the size of the other node set.
+
+Returns:
+- projection: The projected graph, as returned by ProjectedGraph.
+- weights: The weight of every projected edge, keyed with Node1 < Node2.
+*/
+func WeightedProjectedGraph(g *UndirectedGraph, nodes map[Node]bool, ratio bool) (*UndirectedGraph, map[Edge]float64) {
+	counts := sharedNeighbourCounts(g, nodes)
+	otherSide := 0
+	for node := range g.Nodes {
+		if !nodes[node] {
+			otherSide++
+		}
+	}
+
+	weights := make(map[Edge]float64, len(counts))
+	for edge, count := range counts {
+		if ratio {
+			weights[edge] = float64(count) / float64(otherSide)
+		} else {
+			weights[edge] = float64(count)
+		}
+	}
+	return projectionFromCounts(g, nodes, counts), weights
+}
+
+/*
+OverlapWeightedProjectedGraph returns the projection of a bipartite graph onto one of its node
+sets weighted by neighbourhood overlap.
+
+Parameters:
+- g: The bipartite graph.
+- nodes: The nodes to project onto.
+- jaccard: If true, the weight of u - v is |N(u) ∩ N(v)| / |N(u) ∪ N(v)|. If false, it is |N(u) ∩ N(v)| / min(|N(u)|, |N(v)|).
+
+Returns:
+- projection: The projected graph, as returned by ProjectedGraph.
+- weights: The weight of every projected edge, keyed with Node1 < Node2.
+*/
+func OverlapWeightedProjectedGraph(g *UndirectedGraph, nodes map[Node]bool, jaccard bool) (*UndirectedGraph, map[Edge]float64) {
+	counts := sharedNeighbourCounts(g, nodes)
+	neighbours := projectionNeighbours(g, nodes)
+
+	weights := make(map[Edge]float64, len(counts))
+	for edge, count := range counts {
+		size1, size2 := len(neighbours[edge.Node1]), len(neighbours[edge.Node2])
+		if jaccard {
+			weights[edge] = float64(count) / float64(size1+size2-count)
+		} else {
+			weights[edge] = float64(count) / float64(min(size1, size2))
+		}
+	}
+	return projectionFromCounts(g, nodes, counts), weights
+}
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestBipartiteColoring(t *testing.T) {
+	testCases := []struct {
+		name      string
+		graph     *UndirectedGraph
+		bipartite bool
+	}{
+		{name: "Even cycle", graph: CycleGraph(6), bipartite: true},
+		{name: "Odd cycle", graph: CycleGraph(5), bipartite: false},
+		{name: "Ladder graph", graph: LadderGraph(4), bipartite: true},
+		{name: "Complete graph", graph: CompleteGraph(3), bipartite: false},
+		{name: "Complete bipartite graph", graph: CompleteBipartiteGraph(3, 4), bipartite: true},
+		{name: "Null graph", graph: NullGraph(), bipartite: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			coloring, err := BipartiteColoring(tc.graph)
+			if (err == nil) != tc.bipartite || IsBipartite(tc.graph) != tc.bipartite {
+				t.Fatalf("Expected bipartite=%v, but got error %v", tc.bipartite, err)
+			}
+			for _, edge := range tc.graph.GetEdgeTuples() {
+				if tc.bipartite && coloring[edge.Node1] == coloring[edge.Node2] {
+					t.Errorf("Edge %v joins two nodes of colour %d", edge, coloring[edge.Node1])
+				}
+			}
+		})
+	}
+}
+
+func TestBipartiteSets(t *testing.T) {
+	top, bottom, err := BipartiteSets(CompleteBipartiteGraph(2, 3))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !sliceEqual(sortedNodes(top), []Node{0, 1}) || !sliceEqual(sortedNodes(bottom), []Node{2, 3, 4}) {
+		t.Errorf("Unexpected sets %v / %v", top, bottom)
+	}
+	if !IsBipartiteNodeSet(CompleteBipartiteGraph(2, 3), top) {
+		t.Errorf("Expected %v to be a bipartite node set", top)
+	}
+
+	if _, _, err := BipartiteSets(CycleGraph(3)); err == nil {
+		t.Errorf("Expected an error for a triangle")
+	}
+}
+
+func TestProjections(t *testing.T) {
+	// users 0, 1, 2 and items 10, 11, 12
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 10}, {0, 11}, {1, 10}, {1, 11}, {1, 12}, {2, 12}})
+	users := map[Node]bool{0: true, 1: true, 2: true}
+
+	projection := ProjectedGraph(g, users)
+	validateGraph(t, projection, users, map[Node][]Node{0: {1}, 1: {0, 2}, 2: {1}})
+
+	_, weights := WeightedProjectedGraph(g, users, false)
+	if weights[Edge{0, 1}] != 2 || weights[Edge{1, 2}] != 1 {
+		t.Errorf("Unexpected weights %v", weights)
+	}
+
+	_, ratios := WeightedProjectedGraph(g, users, true)
+	if ratios[Edge{0, 1}] != 2.0/3.0 {
+		t.Errorf("Expected ratio 2/3, but got %v", ratios[Edge{0, 1}])
+	}
+
+	_, jaccard := OverlapWeightedProjectedGraph(g, users, true)
+	if jaccard[Edge{0, 1}] != 2.0/3.0 || jaccard[Edge{1, 2}] != 1.0/3.0 {
+		t.Errorf("Unexpected Jaccard weights %v", jaccard)
+	}
+
+	_, overlap := OverlapWeightedProjectedGraph(g, users, false)
+	if overlap[Edge{0, 1}] != 1 || overlap[Edge{1, 2}] != 1 {
+		t.Errorf("Unexpected overlap weights %v", overlap)
+	}
+}
+
+func TestCompleteBipartiteGraph(t *testing.T) {
+	g := CompleteBipartiteGraph(3, 4)
+	if len(g.Nodes) != 7 || g.NumberOfEdges() != 12 {
+		t.Errorf("Expected 7 nodes and 12 edges, but got %d and %d", len(g.Nodes), g.NumberOfEdges())
+	}
+
+	g = CompleteBipartiteGraph(2, 0)
+	if len(g.Nodes) != 2 || g.NumberOfEdges() != 0 {
+		t.Errorf("Expected 2 isolated nodes, but got %v", g)
+	}
+}
+
+func TestRandomBipartiteGraph(t *testing.T) {
+	g, err := RandomBipartiteGraph(20, 30, 0.3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(g.Nodes) != 50 {
+		t.Errorf("Expected 50 nodes, but got %d", len(g.Nodes))
+	}
+	top := make(map[Node]bool)
+	for i := 0; i < 20; i++ {
+		top[Node(i)] = true
+	}
+	if !IsBipartiteNodeSet(g, top) {
+		t.Errorf("Expected nodes 0..19 to form one side of the bipartition")
+	}
+
+	if _, err := RandomBipartiteGraph(2, 2, 1.5); err == nil {
+		t.Errorf("Expected an error for a probability above 1")
+	}
+}
@@ -0,0 +1,258 @@
+package model
+
+import "sort"
+
+/*
+MaximalCliques enumerates the maximal cliques of the graph.
+
+Parameters:
+- g: The undirected graph.
+
+Returns:
+- An iterator following the iter.Seq convention: it calls yield once per maximal clique (sorted in increasing node order) and stops early as soon as yield returns false. Cliques are produced lazily, so huge enumerations do not need to fit in memory.
+
+Description:
+The function implements the Bron-Kerbosch algorithm with Tomita pivoting. The
+outer level processes nodes in degeneracy order (Eppstein, Löffler and Strash),
+which bounds the size of every candidate set by the degeneracy of the graph.
+Isolated nodes are maximal cliques of size one.
+
+Example:
+
+	MaximalCliques(LollipopGraph(4, 2))(func(clique []Node) bool {
+		fmt.Println(clique) // [4 5], then [3 4], then [0 1 2 3], following the degeneracy order
+		return true
+	})
+*/
+func MaximalCliques(g *UndirectedGraph) func(yield func([]Node) bool) {
+	return func(yield func([]Node) bool) {
+		order, _ := degeneracyOrdering(g)
+		position := make(map[Node]int, len(order))
+		for idx, node := range order {
+			position[node] = idx
+		}
+		neighbours := neighbourSets(g)
+
+		stopped := false
+		var clique []Node
+		var expand func(candidates map[Node]bool, excluded map[Node]bool)
+		expand = func(candidates map[Node]bool, excluded map[Node]bool) {
+			if len(candidates) == 0 {
+				if len(excluded) == 0 {
+					result := append([]Node(nil), clique...)
+					sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+					stopped = !yield(result)
+				}
+				return
+			}
+
+			// Pick the pivot covering the largest number of candidates
+			pivot, pivotCover := Node(0), -1
+			for _, set := range []map[Node]bool{candidates, excluded} {
+				for node := range set {
+					cover := 0
+					for candidate := range candidates {
+						if neighbours[node][candidate] {
+							cover++
+						}
+					}
+					if cover > pivotCover || (cover == pivotCover && node < pivot) {
+						pivot, pivotCover = node, cover
+					}
+				}
+			}
+
+			for _, node := range sortedNodes(candidates) {
+				if neighbours[pivot][node] {
+					continue
+				}
+				newCandidates := make(map[Node]bool)
+				newExcluded := make(map[Node]bool)
+				for neighbor := range neighbours[node] {
+					if candidates[neighbor] {
+						newCandidates[neighbor] = true
+					}
+					if excluded[neighbor] {
+						newExcluded[neighbor] = true
+					}
+				}
+				clique = append(clique, node)
+				expand(newCandidates, newExcluded)
+				clique = clique[:len(clique)-1]
+				if stopped {
+					return
+				}
+				delete(candidates, node)
+				excluded[node] = true
+			}
+		}
+
+		for _, node := range order {
+			candidates := make(map[Node]bool)
+			excluded := make(map[Node]bool)
+			for neighbor := range neighbours[node] {
+				if position[neighbor] > position[node] {
+					candidates[neighbor] = true
+				} else {
+					excluded[neighbor] = true
+				}
+			}
+			clique = append(clique[:0], node)
+			expand(candidates, excluded)
+			if stopped {
+				return
+			}
+		}
+	}
+}
+
+// neighbourSets returns the set of distinct neighbours of every node, ignoring self-loops.
+func neighbourSets(g *UndirectedGraph) map[Node]map[Node]bool {
+	neighbours := make(map[Node]map[Node]bool, len(g.Nodes))
+	for node := range g.Nodes {
+		neighbours[node] = make(map[Node]bool)
+		for _, neighbor := range g.Edges[node] {
+			if neighbor != node {
+				neighbours[node][neighbor] = true
+			}
+		}
+	}
+	return neighbours
+}
+
+/*
+MaximumClique returns a clique of maximum size.
+
+Parameters:
+- g: The undirected graph.
+
+Returns:
+- clique: The nodes of a maximum clique in increasing order; empty for the null graph.
+
+Description:
+The function implements the branch and bound algorithm of Tomita and Seki
+(MCQ): candidates are greedily coloured and a branch is pruned as soon as the
+number of colours available can no longer beat the best clique found. The
+worst-case running time is exponential.
+*/
+func MaximumClique(g *UndirectedGraph) []Node {
+	nodes, _, adjacency := simpleAdjacency(g)
+	adjacent := make([]map[int]bool, len(nodes))
+	for v, neighbours := range adjacency {
+		adjacent[v] = make(map[int]bool, len(neighbours))
+		for _, w := range neighbours {
+			adjacent[v][w] = true
+		}
+	}
+
+	var best, current []int
+	var expand func(candidates []int)
+	expand = func(candidates []int) {
+		order, bounds := colorSortCandidates(candidates, adjacent)
+		for i := len(order) - 1; i >= 0; i-- {
+			if len(current)+bounds[i] <= len(best) {
+				return
+			}
+			v := order[i]
+			current = append(current, v)
+			var next []int
+			for _, w := range order[:i] {
+				if adjacent[v][w] {
+					next = append(next, w)
+				}
+			}
+			if len(next) == 0 {
+				if len(current) > len(best) {
+					best = append([]int(nil), current...)
+				}
+			} else {
+				expand(next)
+			}
+			current = current[:len(current)-1]
+		}
+	}
+
+	// Start from nodes sorted by decreasing degree, as suggested by Tomita and Seki
+	initial := make([]int, len(nodes))
+	for i := range initial {
+		initial[i] = i
+	}
+	sort.SliceStable(initial, func(i, j int) bool { return len(adjacency[initial[i]]) > len(adjacency[initial[j]]) })
+	expand(initial)
+
+	clique := make([]Node, len(best))
+	for i, v := range best {
+		clique[i] = nodes[v]
+	}
+	sort.Slice(clique, func(i, j int) bool { return clique[i] < clique[j] })
+	return clique
+}
+
+// colorSortCandidates greedily colours the candidates and returns them sorted
+// by increasing colour together with, for every position, the colour number
+// (1-based), which bounds the size of any clique among the first positions.
+func colorSortCandidates(candidates []int, adjacent []map[int]bool) ([]int, []int) {
+	var classes [][]int
+	for _, v := range candidates {
+		placed := false
+		for c := range classes {
+			conflict := false
+			for _, w := range classes[c] {
+				if adjacent[v][w] {
+					conflict = true
+					break
+				}
+			}
+			if !conflict {
+				classes[c] = append(classes[c], v)
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			classes = append(classes, []int{v})
+		}
+	}
+
+	order := make([]int, 0, len(candidates))
+	bounds := make([]int, 0, len(candidates))
+	for c, class := range classes {
+		for _, v := range class {
+			order = append(order, v)
+			bounds = append(bounds, c+1)
+		}
+	}
+	return order, bounds
+}
+
+// CliqueNumber returns the size of the largest clique of the graph.
+func CliqueNumber(g *UndirectedGraph) int {
+	return len(MaximumClique(g))
+}
+
+// NumberOfCliques returns, for every node, the number of maximal cliques it belongs to.
+func NumberOfCliques(g *UndirectedGraph) map[Node]int {
+	counts := make(map[Node]int, len(g.Nodes))
+	for node := range g.Nodes {
+		counts[node] = 0
+	}
+	MaximalCliques(g)(func(clique []Node) bool {
+		for _, node := range clique {
+			counts[node]++
+		}
+		return true
+	})
+	return counts
+}
+
+// NodeCliqueNumber returns, for every node, the size of the largest maximal clique containing it.
+func NodeCliqueNumber(g *UndirectedGraph) map[Node]int {
+	sizes := make(map[Node]int, len(g.Nodes))
+	MaximalCliques(g)(func(clique []Node) bool {
+		for _, node := range clique {
+			sizes[node] = max(sizes[node], len(clique))
+		}
+		return true
+	})
+	return sizes
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+func collectCliques(g *UndirectedGraph) []string {
+	var cliques []string
+	MaximalCliques(g)(func(clique []Node) bool {
+		cliques = append(cliques, fmt.Sprint(clique))
+		return true
+	})
+	sort.Strings(cliques)
+	return cliques
+}
+
+func TestMaximalCliques(t *testing.T) {
+	testCases := []struct {
+		name     string
+		graph    *UndirectedGraph
+		expected []string
+	}{
+		{name: "Lollipop graph", graph: LollipopGraph(4, 2), expected: []string{"[0 1 2 3]", "[3 4]", "[4 5]"}},
+		{name: "Cycle graph", graph: CycleGraph(4), expected: []string{"[0 1]", "[0 3]", "[1 2]", "[2 3]"}},
+		{name: "Complete graph", graph: CompleteGraph(5), expected: []string{"[0 1 2 3 4]"}},
+		{name: "Trivial graph", graph: TrivialGraph(), expected: []string{"[0]"}},
+		{name: "Null graph", graph: NullGraph(), expected: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cliques := collectCliques(tc.graph)
+			if fmt.Sprint(cliques) != fmt.Sprint(tc.expected) {
+				t.Errorf("Expected %v, but got %v", tc.expected, cliques)
+			}
+		})
+	}
+}
+
+func TestMaximalCliques_StopsEarly(t *testing.T) {
+	calls := 0
+	MaximalCliques(CycleGraph(10))(func(clique []Node) bool {
+		calls++
+		return calls < 3
+	})
+	if calls != 3 {
+		t.Errorf("Expected the enumeration to stop after 3 cliques, but got %d", calls)
+	}
+}
+
+func TestMaximumClique(t *testing.T) {
+	testCases := []struct {
+		name     string
+		graph    *UndirectedGraph
+		expected int
+	}{
+		{name: "Null graph", graph: NullGraph(), expected: 0},
+		{name: "Path graph", graph: PathGraph(5), expected: 2},
+		{name: "Lollipop graph", graph: LollipopGraph(5, 3), expected: 5},
+		{name: "Ladder graph", graph: LadderGraph(4), expected: 2},
+		{name: "Wheel graph", graph: WheelGraph(6), expected: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clique := MaximumClique(tc.graph)
+			if len(clique) != tc.expected || CliqueNumber(tc.graph) != tc.expected {
+				t.Errorf("Expected a clique of size %d, but got %v", tc.expected, clique)
+			}
+			for i := range clique {
+				for j := i + 1; j < len(clique); j++ {
+					if !tc.graph.HasEdge(Edge{Node1: clique[i], Node2: clique[j]}) {
+						t.Errorf("%v is not a clique", clique)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNumberOfCliques(t *testing.T) {
+	g := LollipopGraph(4, 2)
+	counts := NumberOfCliques(g)
+	expected := map[Node]int{0: 1, 1: 1, 2: 1, 3: 2, 4: 2, 5: 1}
+	if fmt.Sprint(counts) != fmt.Sprint(expected) {
+		t.Errorf("Expected %v, but got %v", expected, counts)
+	}
+
+	sizes := NodeCliqueNumber(g)
+	if sizes[3] != 4 || sizes[5] != 2 {
+		t.Errorf("Unexpected node clique numbers %v", sizes)
+	}
+}
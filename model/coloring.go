@@ -0,0 +1,536 @@
+package model
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+type IColoringStrategy interface {
+	Color(graph *UndirectedGraph) map[Node]int
+}
+
+/*
+GREEDY COLORING STRATEGIES
+*/
+type LargestFirstColoring struct{ IColoringStrategy }
+type SmallestLastColoring struct{ IColoringStrategy }
+type DSaturColoring struct{ IColoringStrategy }
+type RandomSequentialColoring struct {
+	IColoringStrategy
+	// Rand is the source of the random order; a randomly seeded source is used if it is nil.
+	Rand *rand.Rand
+}
+type ConnectedSequentialBFSColoring struct{ IColoringStrategy }
+type ConnectedSequentialDFSColoring struct{ IColoringStrategy }
+
+/*
+GreedyColor colours the nodes of the graph so that adjacent nodes get different colours.
+
+Parameters:
+- g: The undirected graph.
+- strategy: The strategy deciding in which order nodes are coloured, e.g. &LargestFirstColoring{} or &DSaturColoring{}.
+
+Returns:
+- coloring: Maps every node to a colour 0, 1, 2, ... Every node gets the smallest colour not used by its already coloured neighbours.
+
+Description:
+Greedy colouring uses at most Δ+1 colours, where Δ is the maximum degree. The
+number of colours actually used depends heavily on the strategy.
+
+Example:
+
+	coloring := GreedyColor(CycleGraph(5), &DSaturColoring{})
+	fmt.Println(NumberOfColors(coloring)) // Output: 3
+*/
+func GreedyColor(g *UndirectedGraph, strategy IColoringStrategy) map[Node]int {
+	return strategy.Color(g)
+}
+
+// greedyColorInOrder assigns to every node, in the given order, the smallest
+// colour not used by its coloured neighbours.
+func greedyColorInOrder(g *UndirectedGraph, order []Node) map[Node]int {
+	coloring := make(map[Node]int, len(order))
+	for _, node := range order {
+		coloring[node] = smallestFreeColor(g, coloring, node)
+	}
+	return coloring
+}
+
+func smallestFreeColor(g *UndirectedGraph, coloring map[Node]int, node Node) int {
+	used := make(map[int]bool)
+	for _, neighbor := range g.Edges[node] {
+		if color, ok := coloring[neighbor]; ok {
+			used[color] = true
+		}
+	}
+	color := 0
+	for used[color] {
+		color++
+	}
+	return color
+}
+
+// Color orders the nodes by decreasing degree.
+func (strategy *LargestFirstColoring) Color(g *UndirectedGraph) map[Node]int {
+	order := sortedNodes(g.Nodes)
+	sort.SliceStable(order, func(i, j int) bool {
+		return g.NodeDegree(order[i]) > g.NodeDegree(order[j])
+	})
+	return greedyColorInOrder(g, order)
+}
+
+// Color repeatedly removes a node of minimum degree from the graph and colours
+// the nodes in the reverse order of removal. This uses at most d+1 colours,
+// where d is the degeneracy of the graph.
+func (strategy *SmallestLastColoring) Color(g *UndirectedGraph) map[Node]int {
+	order, _ := degeneracyOrdering(g)
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+	return greedyColorInOrder(g, order)
+}
+
+// degeneracyOrdering returns the nodes in the order they are removed when
+// repeatedly deleting a node of minimum remaining degree, together with the
+// degeneracy (the largest minimum degree seen).
+func degeneracyOrdering(g *UndirectedGraph) ([]Node, int) {
+	nodes := sortedNodes(g.Nodes)
+	degree := make(map[Node]int, len(nodes))
+	maxDegree := 0
+	for _, node := range nodes {
+		degree[node] = g.NodeDegree(node)
+		maxDegree = max(maxDegree, degree[node])
+	}
+	buckets := make([][]Node, maxDegree+1)
+	for i := len(nodes) - 1; i >= 0; i-- {
+		buckets[degree[nodes[i]]] = append(buckets[degree[nodes[i]]], nodes[i])
+	}
+
+	removed := make(map[Node]bool, len(nodes))
+	order := make([]Node, 0, len(nodes))
+	degeneracy := 0
+	current := 0
+	for len(order) < len(nodes) {
+		current = max(current-1, 0)
+		for len(buckets[current]) == 0 {
+			current++
+		}
+		node := buckets[current][len(buckets[current])-1]
+		buckets[current] = buckets[current][:len(buckets[current])-1]
+		if removed[node] || degree[node] != current {
+			// stale bucket entry
+			continue
+		}
+		removed[node] = true
+		order = append(order, node)
+		degeneracy = max(degeneracy, current)
+		for _, neighbor := range g.Edges[node] {
+			if !removed[neighbor] && neighbor != node {
+				degree[neighbor]--
+				buckets[degree[neighbor]] = append(buckets[degree[neighbor]], neighbor)
+			}
+		}
+	}
+	return order, degeneracy
+}
+
+// Color implements Brélaz's DSatur heuristic: the next node to colour is the
+// one with the most distinct colours among its neighbours (its saturation),
+// ties broken by degree and then by node label.
+func (strategy *DSaturColoring) Color(g *UndirectedGraph) map[Node]int {
+	nodes := sortedNodes(g.Nodes)
+	coloring := make(map[Node]int, len(nodes))
+	saturation := make(map[Node]map[int]bool, len(nodes))
+	for _, node := range nodes {
+		saturation[node] = make(map[int]bool)
+	}
+
+	for len(coloring) < len(nodes) {
+		var selected Node
+		found := false
+		for _, node := range nodes {
+			if _, colored := coloring[node]; colored {
+				continue
+			}
+			if !found ||
+				len(saturation[node]) > len(saturation[selected]) ||
+				(len(saturation[node]) == len(saturation[selected]) && g.NodeDegree(node) > g.NodeDegree(selected)) {
+				selected = node
+				found = true
+			}
+		}
+		color := smallestFreeColor(g, coloring, selected)
+		coloring[selected] = color
+		for _, neighbor := range g.Edges[selected] {
+			saturation[neighbor][color] = true
+		}
+	}
+	return coloring
+}
+
+// Color visits the nodes in a uniformly random order.
+func (strategy *RandomSequentialColoring) Color(g *UndirectedGraph) map[Node]int {
+	nodes := sortedNodes(g.Nodes)
+	order := make([]Node, len(nodes))
+	for i, idx := range randomOrDefault(strategy.Rand).Perm(len(nodes)) {
+		order[i] = nodes[idx]
+	}
+	return greedyColorInOrder(g, order)
+}
+
+// Color visits every connected component in breadth-first order, starting from
+// its smallest node, so that each node (except the first of a component) has
+// an already coloured neighbour.
+func (strategy *ConnectedSequentialBFSColoring) Color(g *UndirectedGraph) map[Node]int {
+	visited := make(map[Node]bool, len(g.Nodes))
+	order := make([]Node, 0, len(g.Nodes))
+	for _, root := range sortedNodes(g.Nodes) {
+		if visited[root] {
+			continue
+		}
+		visited[root] = true
+		queue := []Node{root}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			order = append(order, node)
+			for _, neighbor := range g.Edges[node] {
+				if !visited[neighbor] {
+					visited[neighbor] = true
+					queue = append(queue, neighbor)
+				}
+			}
+		}
+	}
+	return greedyColorInOrder(g, order)
+}
+
+// Color visits every connected component in depth-first preorder, starting
+// from its smallest node.
+func (strategy *ConnectedSequentialDFSColoring) Color(g *UndirectedGraph) map[Node]int {
+	visited := make(map[Node]bool, len(g.Nodes))
+	order := make([]Node, 0, len(g.Nodes))
+	for _, root := range sortedNodes(g.Nodes) {
+		if visited[root] {
+			continue
+		}
+		stack := []Node{root}
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if visited[node] {
+				continue
+			}
+			visited[node] = true
+			order = append(order, node)
+			neighbors := g.Edges[node]
+			for i := len(neighbors) - 1; i >= 0; i-- {
+				if !visited[neighbors[i]] {
+					stack = append(stack, neighbors[i])
+				}
+			}
+		}
+	}
+	return greedyColorInOrder(g, order)
+}
+
+// NumberOfColors returns the number of distinct colours used by a colouring.
+func NumberOfColors(coloring map[Node]int) int {
+	colors := make(map[int]bool)
+	for _, color := range coloring {
+		colors[color] = true
+	}
+	return len(colors)
+}
+
+// IsProperColoring reports whether every node of g is coloured and no edge
+// joins two nodes of the same colour.
+func IsProperColoring(g *UndirectedGraph, coloring map[Node]int) bool {
+	for node := range g.Nodes {
+		if _, ok := coloring[node]; !ok {
+			return false
+		}
+	}
+	for _, edge := range g.GetEdgeTuples() {
+		if coloring[edge.Node1] == coloring[edge.Node2] {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+ChromaticNumber computes the chromatic number of the graph and an optimal colouring.
+
+Parameters:
+- g: The undirected graph. The search is exponential, so it is meant for small graphs (up to a few dozen nodes).
+
+Returns:
+- chromaticNumber: The minimum number of colours needed for a proper colouring.
+- coloring: A proper colouring using exactly chromaticNumber colours.
+
+Description:
+The DSatur colouring gives an upper bound. The function then tries to colour the
+graph with fewer colours using a backtracking search which always branches on
+the uncoloured node of maximum saturation, until no better colouring exists.
+*/
+func ChromaticNumber(g *UndirectedGraph) (int, map[Node]int) {
+	best := GreedyColor(g, &DSaturColoring{})
+	bestCount := NumberOfColors(best)
+
+	nodes, _, adjacency := simpleAdjacency(g)
+	for bestCount > 1 {
+		colors := make([]int, len(nodes))
+		for i := range colors {
+			colors[i] = -1
+		}
+		if !colorWithAtMost(adjacency, colors, bestCount-1, 0) {
+			break
+		}
+		best = make(map[Node]int, len(nodes))
+		for idx, node := range nodes {
+			best[node] = colors[idx]
+		}
+		bestCount = NumberOfColors(best)
+	}
+	return bestCount, best
+}
+
+// colorWithAtMost extends a partial colouring with at most k colours, using
+// colours 0..used-1 plus at most one new colour per branch to break symmetry.
+func colorWithAtMost(adjacency [][]int, colors []int, k int, used int) bool {
+	selected := -1
+	selectedSaturation := -1
+	for v := range colors {
+		if colors[v] >= 0 {
+			continue
+		}
+		seen := make(map[int]bool)
+		for _, w := range adjacency[v] {
+			if colors[w] >= 0 {
+				seen[colors[w]] = true
+			}
+		}
+		if len(seen) > selectedSaturation || (len(seen) == selectedSaturation && len(adjacency[v]) > len(adjacency[selected])) {
+			selected = v
+			selectedSaturation = len(seen)
+		}
+	}
+	if selected < 0 {
+		return true
+	}
+
+	for color := 0; color < min(used+1, k); color++ {
+		conflict := false
+		for _, w := range adjacency[selected] {
+			if colors[w] == color {
+				conflict = true
+				break
+			}
+		}
+		if conflict {
+			continue
+		}
+		colors[selected] = color
+		if colorWithAtMost(adjacency, colors, k, max(used, color+1)) {
+			return true
+		}
+		colors[selected] = -1
+	}
+	return false
+}
+
+/*
+EquitableColor computes an equitable colouring: a proper colouring where the
+sizes of any two colour classes differ by at most one.
+
+Parameters:
+- g: The undirected graph.
+- numberOfColors: The number of colour classes. It must exceed the maximum degree of g; the Hajnal-Szemerédi theorem then guarantees that an equitable colouring exists.
+
+Returns:
+- coloring: Maps every node to a colour in 0..numberOfColors-1.
+- error: If numberOfColors is not larger than the maximum degree.
+
+Description:
+The function starts from a greedy colouring and rebalances it by moving single
+nodes along chains of classes: a node of class X can move to class Y when it
+has no neighbour in Y. If no such chain connects the largest class to the
+smallest one, it falls back to a backtracking search with exact class sizes.
+*/
+func EquitableColor(g *UndirectedGraph, numberOfColors int) (map[Node]int, error) {
+	nodes, _, adjacency := simpleAdjacency(g)
+	maxDegree := 0
+	for _, neighbours := range adjacency {
+		maxDegree = max(maxDegree, len(neighbours))
+	}
+	if numberOfColors <= maxDegree {
+		return nil, fmt.Errorf("number of colors must be greater than the maximum degree %d", maxDegree)
+	}
+
+	colors := make([]int, len(nodes))
+	sizes := make([]int, numberOfColors)
+	for v := range nodes {
+		colors[v] = -1
+	}
+	// Greedy start, always filling the smallest admissible class
+	for v := range nodes {
+		blocked := make(map[int]bool)
+		for _, w := range adjacency[v] {
+			if colors[w] >= 0 {
+				blocked[colors[w]] = true
+			}
+		}
+		best := -1
+		for color := 0; color < numberOfColors; color++ {
+			if !blocked[color] && (best < 0 || sizes[color] < sizes[best]) {
+				best = color
+			}
+		}
+		colors[v] = best
+		sizes[best]++
+	}
+
+	if !balanceColoring(adjacency, colors, sizes) {
+		colors = equitableBacktracking(adjacency, numberOfColors)
+	}
+
+	coloring := make(map[Node]int, len(nodes))
+	for idx, node := range nodes {
+		coloring[node] = colors[idx]
+	}
+	return coloring, nil
+}
+
+// balanceColoring moves nodes between classes until the colouring is
+// equitable. It returns false if it gets stuck.
+func balanceColoring(adjacency [][]int, colors []int, sizes []int) bool {
+	k := len(sizes)
+	for {
+		largest, smallest := 0, 0
+		for color := range sizes {
+			if sizes[color] > sizes[largest] {
+				largest = color
+			}
+			if sizes[color] < sizes[smallest] {
+				smallest = color
+			}
+		}
+		if sizes[largest]-sizes[smallest] <= 1 {
+			return true
+		}
+
+		// movable[x][y] is a node of class x without neighbours in class y, or -1
+		movable := make([][]int, k)
+		for x := range movable {
+			movable[x] = make([]int, k)
+			for y := range movable[x] {
+				movable[x][y] = -1
+			}
+		}
+		for v := range colors {
+			blocked := make(map[int]bool)
+			for _, w := range adjacency[v] {
+				blocked[colors[w]] = true
+			}
+			for y := 0; y < k; y++ {
+				if y != colors[v] && !blocked[y] && movable[colors[v]][y] < 0 {
+					movable[colors[v]][y] = v
+				}
+			}
+		}
+
+		// Breadth-first search for a chain of classes from the largest to the smallest
+		next := make([]int, k)
+		for i := range next {
+			next[i] = -1
+		}
+		next[smallest] = smallest
+		queue := []int{smallest}
+		for len(queue) > 0 && next[largest] < 0 {
+			y := queue[0]
+			queue = queue[1:]
+			for x := 0; x < k; x++ {
+				if next[x] < 0 && movable[x][y] >= 0 {
+					next[x] = y
+					queue = append(queue, x)
+				}
+			}
+		}
+		if next[largest] < 0 {
+			return false
+		}
+
+		// Shift one node along the chain, starting next to the smallest class
+		var chain []int
+		for x := largest; x != smallest; x = next[x] {
+			chain = append(chain, x)
+		}
+		for i := len(chain) - 1; i >= 0; i-- {
+			x := chain[i]
+			v := movable[x][next[x]]
+			colors[v] = next[x]
+			sizes[x]--
+			sizes[next[x]]++
+		}
+	}
+}
+
+// equitableBacktracking searches for a proper colouring in which r classes
+// have size q+1 and the others size q, where n = qk + r.
+func equitableBacktracking(adjacency [][]int, k int) []int {
+	n := len(adjacency)
+	q, r := n/k, n%k
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return len(adjacency[order[i]]) > len(adjacency[order[j]]) })
+
+	colors := make([]int, n)
+	for i := range colors {
+		colors[i] = -1
+	}
+	sizes := make([]int, k)
+	bigClasses := 0
+
+	var search func(position int, used int) bool
+	search = func(position int, used int) bool {
+		if position == n {
+			return true
+		}
+		v := order[position]
+		for color := 0; color < min(used+1, k); color++ {
+			if sizes[color] == q+1 || (sizes[color] == q && bigClasses == r) {
+				continue
+			}
+			conflict := false
+			for _, w := range adjacency[v] {
+				if colors[w] == color {
+					conflict = true
+					break
+				}
+			}
+			if conflict {
+				continue
+			}
+			colors[v] = color
+			sizes[color]++
+			if sizes[color] == q+1 {
+				bigClasses++
+			}
+			if search(position+1, max(used, color+1)) {
+				return true
+			}
+			if sizes[color] == q+1 {
+				bigClasses--
+			}
+			sizes[color]--
+			colors[v] = -1
+		}
+		return false
+	}
+	search(0, 0)
+	return colors
+}
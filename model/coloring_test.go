@@ -0,0 +1,125 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGreedyColor(t *testing.T) {
+	strategies := map[string]IColoringStrategy{
+		"LargestFirst":           &LargestFirstColoring{},
+		"SmallestLast":           &SmallestLastColoring{},
+		"DSatur":                 &DSaturColoring{},
+		"RandomSequential":       &RandomSequentialColoring{},
+		"ConnectedSequentialBFS": &ConnectedSequentialBFSColoring{},
+		"ConnectedSequentialDFS": &ConnectedSequentialDFSColoring{},
+	}
+	graphs := map[string]*UndirectedGraph{
+		"Complete graph": CompleteGraph(6),
+		"Odd cycle":      CycleGraph(7),
+		"Ladder graph":   LadderGraph(5),
+		"Wheel graph":    WheelGraph(6),
+		"Null graph":     NullGraph(),
+	}
+
+	for strategyName, strategy := range strategies {
+		for graphName, graph := range graphs {
+			t.Run(fmt.Sprintf("%s/%s", strategyName, graphName), func(t *testing.T) {
+				coloring := GreedyColor(graph, strategy)
+				if !IsProperColoring(graph, coloring) {
+					t.Errorf("Improper colouring %v", coloring)
+				}
+				maxDegree := 0
+				for node := range graph.Nodes {
+					maxDegree = max(maxDegree, graph.NodeDegree(node))
+				}
+				if len(graph.Nodes) > 0 && NumberOfColors(coloring) > maxDegree+1 {
+					t.Errorf("Expected at most %d colours, but got %d", maxDegree+1, NumberOfColors(coloring))
+				}
+			})
+		}
+	}
+}
+
+func TestGreedyColor_BipartiteStrategies(t *testing.T) {
+	// Strategies visiting nodes along a traversal colour trees and even cycles with 2 colours
+	for _, strategy := range []IColoringStrategy{&DSaturColoring{}, &ConnectedSequentialBFSColoring{}, &SmallestLastColoring{}} {
+		if colors := NumberOfColors(GreedyColor(LadderGraph(6), strategy)); colors != 2 {
+			t.Errorf("%T: expected 2 colours on a ladder graph, but got %d", strategy, colors)
+		}
+	}
+}
+
+func TestChromaticNumber(t *testing.T) {
+	testCases := []struct {
+		name     string
+		graph    *UndirectedGraph
+		expected int
+	}{
+		{name: "Null graph", graph: NullGraph(), expected: 0},
+		{name: "Trivial graph", graph: TrivialGraph(), expected: 1},
+		{name: "Even cycle", graph: CycleGraph(8), expected: 2},
+		{name: "Odd cycle", graph: CycleGraph(9), expected: 3},
+		{name: "Complete graph", graph: CompleteGraph(5), expected: 5},
+		{name: "Lollipop graph", graph: LollipopGraph(4, 3), expected: 4},
+		{name: "Circular ladder graph", graph: circularLadder(5), expected: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chromaticNumber, coloring := ChromaticNumber(tc.graph)
+			if chromaticNumber != tc.expected {
+				t.Errorf("Expected chromatic number %d, but got %d", tc.expected, chromaticNumber)
+			}
+			if !IsProperColoring(tc.graph, coloring) || NumberOfColors(coloring) != chromaticNumber {
+				t.Errorf("Invalid optimal colouring %v", coloring)
+			}
+		})
+	}
+}
+
+func TestEquitableColor(t *testing.T) {
+	testCases := []struct {
+		name           string
+		graph          *UndirectedGraph
+		numberOfColors int
+	}{
+		{name: "Star graph", graph: StarGraph(7), numberOfColors: 7},
+		{name: "Cycle graph", graph: CycleGraph(10), numberOfColors: 3},
+		{name: "Ladder graph", graph: LadderGraph(7), numberOfColors: 4},
+		{name: "Complete graph", graph: CompleteGraph(5), numberOfColors: 5},
+		{name: "Lollipop graph", graph: LollipopGraph(4, 6), numberOfColors: 5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			coloring, err := EquitableColor(tc.graph, tc.numberOfColors)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !IsProperColoring(tc.graph, coloring) {
+				t.Errorf("Improper colouring %v", coloring)
+			}
+			sizes := make([]int, tc.numberOfColors)
+			for _, color := range coloring {
+				sizes[color]++
+			}
+			smallest, largest := sizes[0], sizes[0]
+			for _, size := range sizes {
+				smallest, largest = min(smallest, size), max(largest, size)
+			}
+			if largest-smallest > 1 {
+				t.Errorf("Colour classes are not balanced: %v", sizes)
+			}
+		})
+	}
+
+	if _, err := EquitableColor(StarGraph(5), 3); err == nil {
+		t.Errorf("Expected an error when the number of colours does not exceed the maximum degree")
+	}
+}
+
+func circularLadder(nodesInSinglePath int) *UndirectedGraph {
+	g, _ := CircularLadderGraph(nodesInSinglePath)
+	return g
+}
@@ -1,5 +1,108 @@
 package model
 
+import (
+	"fmt"
+	"sort"
+)
+
 // some common partitioning algorithms:
 // - https://patterns.eecs.berkeley.edu/?page_id=571#1_Find_a_representation_model
 // - https://networkx.org/documentation/stable/reference/algorithms/community.html
+
+/*
+KCliqueCommunities finds communities with the clique percolation method of Palla et al.
+
+Parameters:
+- g: The undirected graph.
+- k: The clique size, at least 2.
+
+Returns:
+- communities: Every community is the union of k-cliques that can be reached from one another through adjacent k-cliques, i.e. k-cliques sharing k-1 nodes. Communities may overlap; they are sorted by their nodes in lexicographic order.
+- error: If k is smaller than 2.
+
+Description:
+Two maximal cliques of size at least k belong to the same community when they
+share at least k-1 nodes, which is equivalent to percolating k-cliques.
+*/
+func KCliqueCommunities(g *UndirectedGraph, k int) ([]map[Node]bool, error) {
+	if k < 2 {
+		return nil, fmt.Errorf("k must be at least 2")
+	}
+
+	var cliques [][]Node
+	MaximalCliques(g)(func(clique []Node) bool {
+		if len(clique) >= k {
+			cliques = append(cliques, clique)
+		}
+		return true
+	})
+
+	// Union-find over the cliques
+	parent := make([]int, len(cliques))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	membership := make(map[Node][]int)
+	for idx, clique := range cliques {
+		for _, node := range clique {
+			membership[node] = append(membership[node], idx)
+		}
+	}
+	for i, clique := range cliques {
+		shared := make(map[int]int)
+		for _, node := range clique {
+			for _, j := range membership[node] {
+				if j > i {
+					shared[j]++
+				}
+			}
+		}
+		for j, count := range shared {
+			if count >= k-1 {
+				parent[find(i)] = find(j)
+			}
+		}
+	}
+
+	grouped := make(map[int]map[Node]bool)
+	for idx, clique := range cliques {
+		root := find(idx)
+		if grouped[root] == nil {
+			grouped[root] = make(map[Node]bool)
+		}
+		for _, node := range clique {
+			grouped[root][node] = true
+		}
+	}
+	type community struct {
+		members map[Node]bool
+		sorted  []Node
+	}
+	found := make([]community, 0, len(grouped))
+	for _, members := range grouped {
+		found = append(found, community{members: members, sorted: sortedNodes(members)})
+	}
+	sort.Slice(found, func(i, j int) bool {
+		a, b := found[i].sorted, found[j].sorted
+		for idx := 0; idx < len(a) && idx < len(b); idx++ {
+			if a[idx] != b[idx] {
+				return a[idx] < b[idx]
+			}
+		}
+		return len(a) < len(b)
+	})
+
+	communities := make([]map[Node]bool, len(found))
+	for idx := range found {
+		communities[idx] = found[idx].members
+	}
+	return communities, nil
+}
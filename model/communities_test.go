@@ -0,0 +1,39 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestKCliqueCommunities(t *testing.T) {
+	// Two K4 sharing two nodes and a triangle hanging off node 7
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{
+		{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3},
+		{2, 4}, {2, 5}, {3, 4}, {3, 5}, {4, 5},
+		{5, 6}, {6, 7}, {7, 8}, {8, 6},
+	})
+
+	communities, err := KCliqueCommunities(g, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := [][]Node{{0, 1, 2, 3, 4, 5}, {6, 7, 8}}
+	if len(communities) != len(expected) {
+		t.Fatalf("Expected %v, but got %v", expected, communities)
+	}
+	for i := range expected {
+		if !sliceEqual(sortedNodes(communities[i]), expected[i]) {
+			t.Errorf("Expected %v, but got %v", expected[i], sortedNodes(communities[i]))
+		}
+	}
+
+	// With k = 4 the two K4 only share 2 nodes and stay separate
+	communities, _ = KCliqueCommunities(g, 4)
+	if len(communities) != 2 {
+		t.Errorf("Expected 2 communities for k=4, but got %v", communities)
+	}
+
+	if _, err := KCliqueCommunities(g, 1); err == nil {
+		t.Errorf("Expected an error for k < 2")
+	}
+}
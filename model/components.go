@@ -0,0 +1,277 @@
+package model
+
+// StronglyConnectedComponents finds the strongly connected components of a
+// directed graph using an iterative version of Tarjan's algorithm.
+//
+// Parameters:
+//   - g: Pointer to a DirectedGraph representing the input graph.
+//
+// Returns:
+//   - components: DirectedComponents struct containing the subgraph induced by
+//     each strongly connected component. Components are emitted in reverse
+//     topological order of the condensation, i.e. a component is always listed
+//     before any component that can reach it.
+//
+// The traversal keeps an explicit call stack instead of recursing, so very deep
+// graphs (e.g. long directed paths) do not overflow the goroutine stack.
+//
+// Example usage:
+//
+//	graph := DirectedGraph{}
+//	graph.AddEdgesFromIntTupleList([][2]int{{1, 2}, {2, 3}, {3, 1}, {3, 4}})
+//	components := StronglyConnectedComponents(&graph)
+//	biggest := components.GetBiggestComponent() // nodes 1, 2, 3
+func StronglyConnectedComponents(g *DirectedGraph) (components DirectedComponents) {
+	components = DirectedComponents{
+		ComponentsArray:     make([]*DirectedGraph, 0),
+		BiggestComponentIdx: -1,
+	}
+
+	type frame struct {
+		node Node
+		next int
+	}
+
+	index := make(map[Node]int, len(g.Nodes))
+	lowLink := make(map[Node]int, len(g.Nodes))
+	onStack := make(map[Node]bool, len(g.Nodes))
+	var stack []Node
+	counter := 0
+
+	visit := func(node Node) {
+		index[node] = counter
+		lowLink[node] = counter
+		counter++
+		stack = append(stack, node)
+		onStack[node] = true
+	}
+
+	for _, root := range sortedNodes(g.Nodes) {
+		if _, visited := index[root]; visited {
+			continue
+		}
+		visit(root)
+		callStack := []frame{{node: root}}
+
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			node := top.node
+			successors := g.Edges[node]
+
+			if top.next < len(successors) {
+				successor := successors[top.next]
+				top.next++
+				if _, visited := index[successor]; !visited {
+					visit(successor)
+					callStack = append(callStack, frame{node: successor})
+				} else if onStack[successor] {
+					lowLink[node] = min(lowLink[node], index[successor])
+				}
+				continue
+			}
+
+			// All successors have been explored, return to the caller
+			callStack = callStack[:len(callStack)-1]
+			if len(callStack) > 0 {
+				parent := callStack[len(callStack)-1].node
+				lowLink[parent] = min(lowLink[parent], lowLink[node])
+			}
+
+			if lowLink[node] == index[node] {
+				members := make(map[Node]bool)
+				for {
+					member := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[member] = false
+					members[member] = true
+					if member == node {
+						break
+					}
+				}
+				components.AddComponent(g.Subgraph(members))
+			}
+		}
+	}
+	return components
+}
+
+// KosarajuStronglyConnectedComponents finds the strongly connected components of
+// a directed graph using Kosaraju's two-pass algorithm. It produces the same
+// partition as StronglyConnectedComponents; components are emitted in
+// topological order of the condensation.
+func KosarajuStronglyConnectedComponents(g *DirectedGraph) (components DirectedComponents) {
+	components = DirectedComponents{
+		ComponentsArray:     make([]*DirectedGraph, 0),
+		BiggestComponentIdx: -1,
+	}
+
+	// First pass: record nodes by increasing finishing time
+	finishOrder := make([]Node, 0, len(g.Nodes))
+	visited := make(map[Node]bool, len(g.Nodes))
+	type frame struct {
+		node Node
+		next int
+	}
+	for _, root := range sortedNodes(g.Nodes) {
+		if visited[root] {
+			continue
+		}
+		visited[root] = true
+		callStack := []frame{{node: root}}
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			successors := g.Edges[top.node]
+			if top.next < len(successors) {
+				successor := successors[top.next]
+				top.next++
+				if !visited[successor] {
+					visited[successor] = true
+					callStack = append(callStack, frame{node: successor})
+				}
+				continue
+			}
+			finishOrder = append(finishOrder, top.node)
+			callStack = callStack[:len(callStack)-1]
+		}
+	}
+
+	// Second pass: explore the reversed graph in decreasing finishing time
+	predecessors := g.Predecessors()
+	assigned := make(map[Node]bool, len(g.Nodes))
+	for i := len(finishOrder) - 1; i >= 0; i-- {
+		root := finishOrder[i]
+		if assigned[root] {
+			continue
+		}
+		members := map[Node]bool{root: true}
+		assigned[root] = true
+		stack := []Node{root}
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, predecessor := range predecessors[node] {
+				if !assigned[predecessor] {
+					assigned[predecessor] = true
+					members[predecessor] = true
+					stack = append(stack, predecessor)
+				}
+			}
+		}
+		components.AddComponent(g.Subgraph(members))
+	}
+	return components
+}
+
+// WeaklyConnectedComponents finds the components of a directed graph when edge
+// directions are ignored. Each component is returned as the induced directed
+// subgraph, so the original orientation of the edges is preserved.
+func WeaklyConnectedComponents(g *DirectedGraph) (components DirectedComponents) {
+	components = DirectedComponents{
+		ComponentsArray:     make([]*DirectedGraph, 0),
+		BiggestComponentIdx: -1,
+	}
+
+	predecessors := g.Predecessors()
+	visited := make(map[Node]bool, len(g.Nodes))
+	for _, root := range sortedNodes(g.Nodes) {
+		if visited[root] {
+			continue
+		}
+		visited[root] = true
+		members := map[Node]bool{root: true}
+		queue := []Node{root}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			for _, neighbours := range [][]Node{g.Edges[node], predecessors[node]} {
+				for _, neighbour := range neighbours {
+					if !visited[neighbour] {
+						visited[neighbour] = true
+						members[neighbour] = true
+						queue = append(queue, neighbour)
+					}
+				}
+			}
+		}
+		components.AddComponent(g.Subgraph(members))
+	}
+	return components
+}
+
+// IsStronglyConnected reports whether every node of the graph can reach every other node.
+// The null graph is not considered strongly connected.
+func IsStronglyConnected(g *DirectedGraph) bool {
+	if len(g.Nodes) == 0 {
+		return false
+	}
+	components := StronglyConnectedComponents(g)
+	return len(components.ComponentsArray) == 1
+}
+
+// IsWeaklyConnected reports whether the graph is connected when edge directions are ignored.
+// The null graph is not considered weakly connected.
+func IsWeaklyConnected(g *DirectedGraph) bool {
+	if len(g.Nodes) == 0 {
+		return false
+	}
+	components := WeaklyConnectedComponents(g)
+	return len(components.ComponentsArray) == 1
+}
+
+// Condensation contracts every strongly connected component into a single node.
+//
+// Parameters:
+//   - g: Pointer to a DirectedGraph representing the input graph.
+//   - components: The strongly connected components of g, as returned by
+//     StronglyConnectedComponents or KosarajuStronglyConnectedComponents.
+//
+// Returns:
+//   - condensation: A directed acyclic graph whose node i stands for
+//     components.ComponentsArray[i]. There is an edge i -> j when some node of
+//     component i has an edge to some node of component j.
+//   - membership: Maps every node of g to the condensation node containing it.
+func Condensation(g *DirectedGraph, components DirectedComponents) (condensation *DirectedGraph, membership map[Node]Node) {
+	condensation = &DirectedGraph{
+		Nodes: make(map[Node]bool, len(components.ComponentsArray)),
+		Edges: make(map[Node][]Node),
+	}
+	membership = make(map[Node]Node, len(g.Nodes))
+	for idx, component := range components.ComponentsArray {
+		condensation.AddNode(Node(idx))
+		for node := range component.Nodes {
+			membership[node] = Node(idx)
+		}
+	}
+
+	added := make(map[Edge]bool)
+	for node, successors := range g.Edges {
+		for _, successor := range successors {
+			edge := Edge{Node1: membership[node], Node2: membership[successor]}
+			if edge.Node1 == edge.Node2 || added[edge] {
+				continue
+			}
+			added[edge] = true
+			condensation.AddEdge(edge)
+		}
+	}
+	return condensation, membership
+}
+
+// AttractingComponents returns the strongly connected components that have no
+// edge leaving them. A random walk on the graph eventually gets trapped in one
+// of these components.
+func AttractingComponents(g *DirectedGraph) (components DirectedComponents) {
+	components = DirectedComponents{
+		ComponentsArray:     make([]*DirectedGraph, 0),
+		BiggestComponentIdx: -1,
+	}
+
+	strongComponents := StronglyConnectedComponents(g)
+	condensation, _ := Condensation(g, strongComponents)
+	for idx, component := range strongComponents.ComponentsArray {
+		if condensation.OutDegree(Node(idx)) == 0 {
+			components.AddComponent(component)
+		}
+	}
+	return components
+}
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"sort"
+	"testing"
+)
+
+func componentNodeSets(components DirectedComponents) [][]Node {
+	sets := make([][]Node, 0, len(components.ComponentsArray))
+	for _, component := range components.ComponentsArray {
+		sets = append(sets, sortedNodes(component.Nodes))
+	}
+	sort.Slice(sets, func(i, j int) bool { return sets[i][0] < sets[j][0] })
+	return sets
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	testCases := []struct {
+		name     string
+		edges    [][2]int
+		expected [][]Node
+	}{
+		{
+			name:     "Single cycle",
+			edges:    [][2]int{{1, 2}, {2, 3}, {3, 1}},
+			expected: [][]Node{{1, 2, 3}},
+		},
+		{
+			name:     "Two cycles joined by a bridge",
+			edges:    [][2]int{{1, 2}, {2, 1}, {2, 3}, {3, 4}, {4, 5}, {5, 3}},
+			expected: [][]Node{{1, 2}, {3, 4, 5}},
+		},
+		{
+			name:     "Directed path",
+			edges:    [][2]int{{1, 2}, {2, 3}},
+			expected: [][]Node{{1}, {2}, {3}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			graph := DirectedGraph{}
+			graph.AddEdgesFromIntTupleList(tc.edges)
+
+			for name, components := range map[string]DirectedComponents{
+				"Tarjan":   StronglyConnectedComponents(&graph),
+				"Kosaraju": KosarajuStronglyConnectedComponents(&graph),
+			} {
+				sets := componentNodeSets(components)
+				if len(sets) != len(tc.expected) {
+					t.Fatalf("%s: expected %v, but got %v", name, tc.expected, sets)
+				}
+				for i := range sets {
+					if !sliceEqual(sets[i], tc.expected[i]) {
+						t.Errorf("%s: expected %v, but got %v", name, tc.expected, sets)
+					}
+				}
+				biggestSize := 0
+				for _, set := range tc.expected {
+					biggestSize = max(biggestSize, len(set))
+				}
+				if len(components.GetBiggestComponent().Nodes) != biggestSize {
+					t.Errorf("%s: expected biggest component of size %d, but got %v", name, biggestSize, components.GetBiggestComponent())
+				}
+			}
+		})
+	}
+}
+
+func TestStronglyConnectedComponents_DeepPath(t *testing.T) {
+	graph := DirectedGraph{}
+	for i := 0; i < 100000; i++ {
+		graph.AddEdge(Edge{Node1: Node(i), Node2: Node(i + 1)})
+	}
+	graph.AddEdge(Edge{Node1: 100000, Node2: 0})
+
+	if !IsStronglyConnected(&graph) {
+		t.Errorf("Expected a long directed cycle to be strongly connected")
+	}
+}
+
+func TestWeaklyConnectedComponents(t *testing.T) {
+	graph := DirectedGraph{}
+	graph.AddEdgesFromIntTupleList([][2]int{{1, 2}, {3, 2}, {4, 5}})
+	graph.AddNode(6)
+
+	sets := componentNodeSets(WeaklyConnectedComponents(&graph))
+	expected := [][]Node{{1, 2, 3}, {4, 5}, {6}}
+	if len(sets) != len(expected) {
+		t.Fatalf("Expected %v, but got %v", expected, sets)
+	}
+	for i := range sets {
+		if !sliceEqual(sets[i], expected[i]) {
+			t.Errorf("Expected %v, but got %v", expected, sets)
+		}
+	}
+	if IsWeaklyConnected(&graph) {
+		t.Errorf("Expected graph not to be weakly connected")
+	}
+}
+
+func TestCondensation(t *testing.T) {
+	graph := DirectedGraph{}
+	graph.AddEdgesFromIntTupleList([][2]int{{1, 2}, {2, 1}, {2, 3}, {1, 3}, {3, 4}, {4, 3}})
+
+	components := StronglyConnectedComponents(&graph)
+	condensation, membership := Condensation(&graph, components)
+
+	if len(condensation.Nodes) != 2 || condensation.NumberOfEdges() != 1 {
+		t.Fatalf("Expected 2 nodes and 1 edge, but got %v", condensation)
+	}
+	if !condensation.HasEdge(Edge{Node1: membership[1], Node2: membership[3]}) {
+		t.Errorf("Expected an edge from the component of 1 to the component of 3")
+	}
+	if membership[1] != membership[2] || membership[3] != membership[4] {
+		t.Errorf("Unexpected membership %v", membership)
+	}
+}
+
+func TestAttractingComponents(t *testing.T) {
+	graph := DirectedGraph{}
+	graph.AddEdgesFromIntTupleList([][2]int{{1, 2}, {2, 3}, {3, 2}, {1, 4}})
+
+	sets := componentNodeSets(AttractingComponents(&graph))
+	expected := [][]Node{{2, 3}, {4}}
+	if len(sets) != len(expected) {
+		t.Fatalf("Expected %v, but got %v", expected, sets)
+	}
+	for i := range sets {
+		if !sliceEqual(sets[i], expected[i]) {
+			t.Errorf("Expected %v, but got %v", expected, sets)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package model
+
+import "fmt"
+
+// hasSelfLoop reports whether node is adjacent to itself.
+func hasSelfLoop(g *UndirectedGraph, node Node) bool {
+	for _, neighbor := range g.Edges[node] {
+		if neighbor == node {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+MaximalIndependentSet returns a random maximal independent set of the graph.
+
+Parameters:
+- g: The undirected graph.
+- nodes: Nodes that must be part of the set; may be nil.
+- options: Optional source of randomness, e.g. WithSeed(42) for a reproducible set.
+
+Returns:
+- set: An independent set containing nodes to which no other node can be added.
+- error: If nodes contains a node not in g or is not itself independent.
+
+Description:
+Starting from nodes, the function repeatedly adds a node picked uniformly at
+random among those not adjacent to the set, until none is left. Nodes with a
+self-loop are never independent and are skipped.
+
+Example:
+
+	set, _ := MaximalIndependentSet(PathGraph(5), map[Node]bool{1: true})
+	fmt.Println(set) // Output: map[1:true 3:true] or map[1:true 4:true]
+*/
+func MaximalIndependentSet(g *UndirectedGraph, nodes map[Node]bool, options ...RandomOption) (map[Node]bool, error) {
+	set := make(map[Node]bool, len(nodes))
+	for node := range nodes {
+		if !g.HasNode(node) {
+			return nil, fmt.Errorf("node %d is not in the graph", node)
+		}
+		set[node] = true
+	}
+	if !IsIndependentSet(g, set) {
+		return nil, fmt.Errorf("the given nodes are not an independent set")
+	}
+
+	blocked := make(map[Node]bool)
+	for node := range set {
+		blocked[node] = true
+		for _, neighbor := range g.Edges[node] {
+			blocked[neighbor] = true
+		}
+	}
+	var available []Node
+	for _, node := range sortedNodes(g.Nodes) {
+		if !blocked[node] && !hasSelfLoop(g, node) {
+			available = append(available, node)
+		}
+	}
+
+	for _, idx := range newRandom(options...).Perm(len(available)) {
+		node := available[idx]
+		if blocked[node] {
+			continue
+		}
+		set[node] = true
+		blocked[node] = true
+		for _, neighbor := range g.Edges[node] {
+			blocked[neighbor] = true
+		}
+	}
+	return set, nil
+}
+
+/*
+MaximumIndependentSet returns an independent set of maximum size.
+
+Parameters:
+- g: The undirected graph.
+
+Returns:
+- set: A maximum independent set; empty for the null graph.
+
+Description:
+The problem is NP-hard and the function is meant for small graphs. It branches
+on a node v of minimum degree: some maximum independent set contains v or one
+of its neighbours, so each choice of u in the closed neighbourhood of v is
+explored after removing u and its neighbours. Branches that cannot beat the
+best set found are pruned. Nodes with a self-loop are never selected.
+*/
+func MaximumIndependentSet(g *UndirectedGraph) map[Node]bool {
+	neighbours := neighbourSets(g)
+	alive := make(map[Node]bool, len(g.Nodes))
+	for node := range g.Nodes {
+		if !hasSelfLoop(g, node) {
+			alive[node] = true
+		}
+	}
+
+	var best, current []Node
+	var search func(alive map[Node]bool)
+	search = func(alive map[Node]bool) {
+		if len(alive) == 0 {
+			if len(current) > len(best) {
+				best = append([]Node(nil), current...)
+			}
+			return
+		}
+		if len(current)+len(alive) <= len(best) {
+			return
+		}
+
+		// Pick the alive node with the fewest alive neighbours
+		pivot, pivotDegree := Node(0), -1
+		for _, node := range sortedNodes(alive) {
+			degree := 0
+			for neighbor := range neighbours[node] {
+				if alive[neighbor] {
+					degree++
+				}
+			}
+			if pivotDegree < 0 || degree < pivotDegree {
+				pivot, pivotDegree = node, degree
+			}
+		}
+
+		branches := []Node{pivot}
+		if pivotDegree > 1 {
+			for _, neighbor := range sortedNodes(neighbours[pivot]) {
+				if alive[neighbor] {
+					branches = append(branches, neighbor)
+				}
+			}
+		}
+		for _, node := range branches {
+			remaining := make(map[Node]bool, len(alive))
+			for other := range alive {
+				if other != node && !neighbours[node][other] {
+					remaining[other] = true
+				}
+			}
+			current = append(current, node)
+			search(remaining)
+			current = current[:len(current)-1]
+		}
+	}
+	search(alive)
+
+	set := make(map[Node]bool, len(best))
+	for _, node := range best {
+		set[node] = true
+	}
+	return set
+}
+
+// IsIndependentSet reports whether no two nodes of the set are adjacent.
+func IsIndependentSet(g *UndirectedGraph, nodes map[Node]bool) bool {
+	for node := range nodes {
+		for _, neighbor := range g.Edges[node] {
+			if nodes[neighbor] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+/*
+ApproximateVertexCover returns a vertex cover whose weight is at most twice the minimum.
+
+Parameters:
+- g: The undirected graph.
+- weights: The weight of every node; missing nodes (or a nil map) weigh 1.
+
+Returns:
+- cover: A set of nodes touching every edge of the graph.
+
+Description:
+The function implements the local-ratio algorithm of Bar-Yehuda and Even: every
+edge not yet covered lowers the residual weight of both endpoints by the
+smaller of the two, and nodes whose residual weight drops to zero join the
+cover. It runs in linear time.
+*/
+func ApproximateVertexCover(g *UndirectedGraph, weights map[Node]float64) map[Node]bool {
+	residual := make(map[Node]float64, len(g.Nodes))
+	for node := range g.Nodes {
+		residual[node] = nodeWeight(weights, node)
+	}
+
+	cover := make(map[Node]bool)
+	for _, edge := range g.GetEdgeTuples() {
+		if cover[edge.Node1] || cover[edge.Node2] {
+			continue
+		}
+		paid := min(residual[edge.Node1], residual[edge.Node2])
+		residual[edge.Node1] -= paid
+		residual[edge.Node2] -= paid
+		if residual[edge.Node1] <= 0 {
+			cover[edge.Node1] = true
+		} else {
+			cover[edge.Node2] = true
+		}
+	}
+	return cover
+}
+
+// IsVertexCover reports whether every edge of the graph has an endpoint in the set.
+func IsVertexCover(g *UndirectedGraph, nodes map[Node]bool) bool {
+	for _, edge := range g.GetEdgeTuples() {
+		if !nodes[edge.Node1] && !nodes[edge.Node2] {
+			return false
+		}
+	}
+	return true
+}
+
+// nodeWeight returns the weight of node, defaulting to 1 when it is missing.
+func nodeWeight(weights map[Node]float64, node Node) float64 {
+	if weight, ok := weights[node]; ok {
+		return weight
+	}
+	return 1
+}
+
+/*
+GreedyDominatingSet returns a dominating set within a logarithmic factor of the minimum weight.
+
+Parameters:
+- g: The undirected graph.
+- weights: The weight of every node; missing nodes (or a nil map) weigh 1.
+
+Returns:
+- set: A set of nodes such that every node of the graph is in the set or adjacent to it.
+
+Description:
+The function repeatedly picks the node whose closed neighbourhood covers the
+most undominated nodes per unit of weight (ties broken by the smallest node),
+which yields an O(log Δ) approximation of the minimum dominating set.
+
+Example:
+
+	set := GreedyDominatingSet(StarGraph(5), nil)
+	fmt.Println(set) // Output: map[0:true]
+*/
+func GreedyDominatingSet(g *UndirectedGraph, weights map[Node]float64) map[Node]bool {
+	neighbours := neighbourSets(g)
+	dominated := make(map[Node]bool, len(g.Nodes))
+	set := make(map[Node]bool)
+	nodes := sortedNodes(g.Nodes)
+
+	for len(dominated) < len(g.Nodes) {
+		best, bestScore := Node(0), -1.0
+		for _, node := range nodes {
+			if set[node] {
+				continue
+			}
+			gain := 0
+			if !dominated[node] {
+				gain++
+			}
+			for neighbor := range neighbours[node] {
+				if !dominated[neighbor] {
+					gain++
+				}
+			}
+			if gain == 0 {
+				continue
+			}
+			score := float64(gain) / nodeWeight(weights, node)
+			if score > bestScore {
+				best, bestScore = node, score
+			}
+		}
+
+		set[best] = true
+		dominated[best] = true
+		for neighbor := range neighbours[best] {
+			dominated[neighbor] = true
+		}
+	}
+	return set
+}
+
+// IsDominatingSet reports whether every node of the graph is in the set or adjacent to a node of it.
+func IsDominatingSet(g *UndirectedGraph, nodes map[Node]bool) bool {
+	dominated := make(map[Node]bool, len(g.Nodes))
+	for node := range nodes {
+		if !g.HasNode(node) {
+			return false
+		}
+		dominated[node] = true
+		for _, neighbor := range g.Edges[node] {
+			dominated[neighbor] = true
+		}
+	}
+	return len(dominated) == len(g.Nodes)
+}
@@ -0,0 +1,146 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestMaximalIndependentSet(t *testing.T) {
+	testCases := []struct {
+		name  string
+		graph *UndirectedGraph
+		seed  map[Node]bool
+	}{
+		{name: "Path graph", graph: PathGraph(7), seed: nil},
+		{name: "Cycle graph with seed", graph: CycleGraph(8), seed: map[Node]bool{0: true, 4: true}},
+		{name: "Complete graph", graph: CompleteGraph(5), seed: map[Node]bool{2: true}},
+		{name: "Lollipop graph", graph: LollipopGraph(4, 3), seed: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				set, err := MaximalIndependentSet(tc.graph, tc.seed)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if !IsIndependentSet(tc.graph, set) {
+					t.Fatalf("%v is not independent", set)
+				}
+				// Maximality: every other node has a neighbour in the set
+				if !IsDominatingSet(tc.graph, set) {
+					t.Fatalf("%v is not maximal", set)
+				}
+				for node := range tc.seed {
+					if !set[node] {
+						t.Fatalf("%v does not contain seed node %d", set, node)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMaximalIndependentSet_InvalidSeed(t *testing.T) {
+	if _, err := MaximalIndependentSet(PathGraph(4), map[Node]bool{1: true, 2: true}); err == nil {
+		t.Errorf("Expected an error for a dependent seed")
+	}
+	if _, err := MaximalIndependentSet(PathGraph(4), map[Node]bool{9: true}); err == nil {
+		t.Errorf("Expected an error for a missing node")
+	}
+}
+
+func TestMaximumIndependentSet(t *testing.T) {
+	testCases := []struct {
+		name     string
+		graph    *UndirectedGraph
+		expected int
+	}{
+		{name: "Null graph", graph: NullGraph(), expected: 0},
+		{name: "Path graph", graph: PathGraph(7), expected: 4},
+		{name: "Cycle graph", graph: CycleGraph(7), expected: 3},
+		{name: "Complete graph", graph: CompleteGraph(6), expected: 1},
+		{name: "Star graph", graph: StarGraph(6), expected: 5},
+		{name: "Circular ladder", graph: circularLadder(5), expected: 4},
+		{name: "Lollipop graph", graph: LollipopGraph(5, 4), expected: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			set := MaximumIndependentSet(tc.graph)
+			if len(set) != tc.expected {
+				t.Errorf("Expected size %d, but got %v", tc.expected, set)
+			}
+			if !IsIndependentSet(tc.graph, set) {
+				t.Errorf("%v is not independent", set)
+			}
+		})
+	}
+}
+
+func TestApproximateVertexCover(t *testing.T) {
+	testCases := []struct {
+		name    string
+		graph   *UndirectedGraph
+		weights map[Node]float64
+		optimum float64
+	}{
+		{name: "Star graph", graph: StarGraph(6), weights: nil, optimum: 1},
+		{name: "Path graph", graph: PathGraph(6), weights: nil, optimum: 3},
+		{name: "Complete graph", graph: CompleteGraph(5), weights: nil, optimum: 4},
+		{name: "Weighted star", graph: StarGraph(4), weights: map[Node]float64{0: 10}, optimum: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cover := ApproximateVertexCover(tc.graph, tc.weights)
+			if !IsVertexCover(tc.graph, cover) {
+				t.Fatalf("%v is not a vertex cover", cover)
+			}
+			total := 0.0
+			for node := range cover {
+				total += nodeWeight(tc.weights, node)
+			}
+			if total > 2*tc.optimum {
+				t.Errorf("Cover %v weighs %v, more than twice the optimum %v", cover, total, tc.optimum)
+			}
+		})
+	}
+}
+
+func TestGreedyDominatingSet(t *testing.T) {
+	testCases := []struct {
+		name     string
+		graph    *UndirectedGraph
+		expected []Node
+	}{
+		{name: "Star graph", graph: StarGraph(6), expected: []Node{0}},
+		{name: "Complete graph", graph: CompleteGraph(4), expected: []Node{0}},
+		{name: "Path graph", graph: PathGraph(6), expected: []Node{1, 4}},
+		{name: "Null graph", graph: NullGraph(), expected: []Node{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			set := GreedyDominatingSet(tc.graph, nil)
+			if !IsDominatingSet(tc.graph, set) {
+				t.Fatalf("%v is not a dominating set", set)
+			}
+			if !sliceEqual(sortedNodes(set), tc.expected) {
+				t.Errorf("Expected %v, but got %v", tc.expected, sortedNodes(set))
+			}
+		})
+	}
+}
+
+func TestCoveringPredicates(t *testing.T) {
+	g := PathGraph(4)
+	if IsIndependentSet(g, map[Node]bool{0: true, 1: true}) || !IsIndependentSet(g, map[Node]bool{0: true, 2: true}) {
+		t.Errorf("IsIndependentSet returned a wrong answer")
+	}
+	if IsVertexCover(g, map[Node]bool{0: true, 3: true}) || !IsVertexCover(g, map[Node]bool{1: true, 2: true}) {
+		t.Errorf("IsVertexCover returned a wrong answer")
+	}
+	if IsDominatingSet(g, map[Node]bool{0: true}) || !IsDominatingSet(g, map[Node]bool{0: true, 3: true}) {
+		t.Errorf("IsDominatingSet returned a wrong answer")
+	}
+}
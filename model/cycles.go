@@ -0,0 +1,567 @@
+package model
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+)
+
+/*
+FindCycle returns a cycle of the undirected graph.
+
+Parameters:
+- g: The undirected graph.
+
+Returns:
+- cycle: The nodes of the cycle in traversal order, the closing node not repeated; nil if the graph is a forest.
+
+Description:
+The function works on the underlying simple graph: self-loops and parallel
+edges are ignored. It runs a depth-first search from the smallest node of
+every component and stops at the first back edge.
+
+Example:
+
+	cycle := FindCycle(LollipopGraph(3, 2))
+	fmt.Println(cycle) // Output: [0 1 2]
+*/
+func FindCycle(g *UndirectedGraph) []Node {
+	nodes, _, adjacency := simpleAdjacency(g)
+	parent := make([]int, len(nodes))
+	visited := make([]bool, len(nodes))
+	next := make([]int, len(nodes))
+
+	for root := range nodes {
+		if visited[root] {
+			continue
+		}
+		visited[root] = true
+		parent[root] = -1
+		stack := []int{root}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			if next[v] == len(adjacency[v]) {
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			w := adjacency[v][next[v]]
+			next[v]++
+			if w == parent[v] {
+				continue
+			}
+			if visited[w] {
+				// In an undirected depth-first search every non-tree edge leads to an ancestor
+				cycle := []Node{nodes[v]}
+				for u := v; u != w; {
+					u = parent[u]
+					cycle = append(cycle, nodes[u])
+				}
+				for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				return cycle
+			}
+			visited[w] = true
+			parent[w] = v
+			stack = append(stack, w)
+		}
+	}
+	return nil
+}
+
+// HasCycle reports whether the undirected graph contains a cycle, ignoring self-loops and parallel edges.
+func HasCycle(g *UndirectedGraph) bool {
+	return FindCycle(g) != nil
+}
+
+/*
+FindDirectedCycle returns a directed cycle of the graph.
+
+Parameters:
+- g: The directed graph.
+
+Returns:
+- cycle: The nodes of the cycle in the direction of its edges, the closing node not repeated; nil if the graph is acyclic. A self-loop is a cycle of length one.
+*/
+func FindDirectedCycle(g *DirectedGraph) []Node {
+	const (
+		unvisited = iota
+		onStack
+		finished
+	)
+	state := make(map[Node]int, len(g.Nodes))
+	parent := make(map[Node]Node, len(g.Nodes))
+	next := make(map[Node]int, len(g.Nodes))
+
+	for _, root := range sortedNodes(g.Nodes) {
+		if state[root] != unvisited {
+			continue
+		}
+		state[root] = onStack
+		stack := []Node{root}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			if next[v] == len(g.Edges[v]) {
+				state[v] = finished
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			w := g.Edges[v][next[v]]
+			next[v]++
+			switch state[w] {
+			case onStack:
+				cycle := []Node{v}
+				for u := v; u != w; {
+					u = parent[u]
+					cycle = append(cycle, u)
+				}
+				for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				return cycle
+			case unvisited:
+				state[w] = onStack
+				parent[w] = v
+				stack = append(stack, w)
+			}
+		}
+	}
+	return nil
+}
+
+// HasDirectedCycle reports whether the directed graph contains a cycle, self-loops included.
+func HasDirectedCycle(g *DirectedGraph) bool {
+	return FindDirectedCycle(g) != nil
+}
+
+/*
+CycleBasis returns a fundamental cycle basis of the undirected graph.
+
+Parameters:
+- g: The undirected graph.
+
+Returns:
+- basis: One cycle per edge outside a breadth-first spanning forest, each given as nodes in traversal order. The basis has |E| - |V| + c cycles, where c is the number of connected components.
+
+Description:
+Every non-tree edge u - v closes exactly one cycle with the tree paths from u
+and v to their lowest common ancestor. Self-loops and parallel edges are ignored.
+*/
+func CycleBasis(g *UndirectedGraph) [][]Node {
+	nodes, _, adjacency := simpleAdjacency(g)
+	parent := make([]int, len(nodes))
+	depth := make([]int, len(nodes))
+	for v := range parent {
+		parent[v] = -2
+	}
+
+	var basis [][]Node
+	for root := range nodes {
+		if parent[root] != -2 {
+			continue
+		}
+		parent[root] = -1
+		queue := []int{root}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			for _, w := range adjacency[v] {
+				if parent[w] == -2 {
+					parent[w] = v
+					depth[w] = depth[v] + 1
+					queue = append(queue, w)
+				} else if w > v && parent[w] != v && parent[v] != w {
+					basis = append(basis, treeCycle(nodes, parent, depth, v, w))
+				}
+			}
+		}
+	}
+	return basis
+}
+
+// treeCycle returns the cycle closed by the non-tree edge u - v in a rooted spanning tree.
+func treeCycle(nodes []Node, parent []int, depth []int, u int, v int) []Node {
+	var fromU, fromV []Node
+	for depth[u] > depth[v] {
+		fromU = append(fromU, nodes[u])
+		u = parent[u]
+	}
+	for depth[v] > depth[u] {
+		fromV = append(fromV, nodes[v])
+		v = parent[v]
+	}
+	for u != v {
+		fromU = append(fromU, nodes[u])
+		fromV = append(fromV, nodes[v])
+		u, v = parent[u], parent[v]
+	}
+	cycle := append(fromU, nodes[u])
+	for i := len(fromV) - 1; i >= 0; i-- {
+		cycle = append(cycle, fromV[i])
+	}
+	return cycle
+}
+
+/*
+MinimumCycleBasis returns a cycle basis of minimum total weight.
+
+Parameters:
+- g: The undirected graph.
+- weights: The weight of every edge, looked up in either orientation; missing edges (or a nil map) weigh 1.
+
+Returns:
+- basis: The cycles of the basis sorted by increasing weight, each given as nodes in traversal order.
+
+Description:
+The function implements Horton's algorithm. For every node x and edge u - v,
+the shortest paths from x to u and v close a candidate cycle. Candidates are
+sorted by weight and greedily added to the basis when they are linearly
+independent, over GF(2), of the cycles already selected. The running time is
+polynomial but large, so the function suits small and medium graphs.
+Self-loops and parallel edges are ignored.
+*/
+func MinimumCycleBasis(g *UndirectedGraph, weights map[Edge]float64) [][]Node {
+	nodes, _, adjacency := simpleAdjacency(g)
+	n := len(nodes)
+
+	type pair struct{ u, v int }
+	var edges []pair
+	edgeIdx := make(map[pair]int)
+	components := make([]int, n)
+	for v := range components {
+		components[v] = -1
+	}
+	numberOfComponents := 0
+	for v := range adjacency {
+		for _, w := range adjacency[v] {
+			if v < w {
+				edgeIdx[pair{v, w}] = len(edges)
+				edges = append(edges, pair{v, w})
+			}
+		}
+		if components[v] < 0 {
+			components[v] = numberOfComponents
+			queue := []int{v}
+			for len(queue) > 0 {
+				u := queue[0]
+				queue = queue[1:]
+				for _, w := range adjacency[u] {
+					if components[w] < 0 {
+						components[w] = numberOfComponents
+						queue = append(queue, w)
+					}
+				}
+			}
+			numberOfComponents++
+		}
+	}
+	dimension := len(edges) - n + numberOfComponents
+	if dimension == 0 {
+		return nil
+	}
+	words := (len(edges) + 63) / 64
+	weight := func(u, v int) float64 {
+		return undirectedEdgeWeight(weights, Edge{Node1: nodes[u], Node2: nodes[v]})
+	}
+
+	type candidate struct {
+		weight float64
+		cycle  []int
+		vector []uint64
+	}
+	var candidates []candidate
+	for x := 0; x < n; x++ {
+		dist, parent := denseDijkstra(adjacency, weight, x)
+		for _, e := range edges {
+			u, v := e.u, e.v
+			if math.IsInf(dist[u], 1) || math.IsInf(dist[v], 1) || parent[u] == v || parent[v] == u {
+				continue
+			}
+			pathU := treePath(parent, u)
+			pathV := treePath(parent, v)
+			onPathU := make(map[int]bool, len(pathU))
+			for _, w := range pathU {
+				onPathU[w] = true
+			}
+			simple := true
+			for _, w := range pathV[:len(pathV)-1] {
+				if onPathU[w] {
+					simple = false
+					break
+				}
+			}
+			if !simple {
+				continue
+			}
+
+			// Cycle: x -> ... -> u -> v -> ... -> x
+			cycle := make([]int, 0, len(pathU)+len(pathV)-1)
+			for i := len(pathU) - 1; i >= 0; i-- {
+				cycle = append(cycle, pathU[i])
+			}
+			cycle = append(cycle, pathV[:len(pathV)-1]...)
+			vector := make([]uint64, words)
+			for i := range cycle {
+				a, b := cycle[i], cycle[(i+1)%len(cycle)]
+				if a > b {
+					a, b = b, a
+				}
+				id := edgeIdx[pair{a, b}]
+				vector[id/64] ^= 1 << (id % 64)
+			}
+			candidates = append(candidates, candidate{
+				weight: dist[u] + dist[v] + weight(u, v),
+				cycle:  cycle,
+				vector: vector,
+			})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].weight != candidates[j].weight {
+			return candidates[i].weight < candidates[j].weight
+		}
+		return len(candidates[i].cycle) < len(candidates[j].cycle)
+	})
+
+	// Gaussian elimination over GF(2), rows indexed by their leading bit
+	reduced := make(map[int][]uint64)
+	var basis [][]Node
+	for _, c := range candidates {
+		vector := append([]uint64(nil), c.vector...)
+		for {
+			lead := leadingBit(vector)
+			if lead < 0 {
+				break
+			}
+			row, ok := reduced[lead]
+			if !ok {
+				reduced[lead] = vector
+				cycle := make([]Node, len(c.cycle))
+				for i, v := range c.cycle {
+					cycle[i] = nodes[v]
+				}
+				basis = append(basis, cycle)
+				break
+			}
+			for i := range vector {
+				vector[i] ^= row[i]
+			}
+		}
+		if len(basis) == dimension {
+			break
+		}
+	}
+	return basis
+}
+
+// leadingBit returns the index of the highest set bit of the vector, or -1 if it is zero.
+func leadingBit(vector []uint64) int {
+	for i := len(vector) - 1; i >= 0; i-- {
+		if vector[i] != 0 {
+			return i*64 + 63 - bits.LeadingZeros64(vector[i])
+		}
+	}
+	return -1
+}
+
+// denseDijkstra computes shortest path distances and a shortest path tree from source in O(V²).
+func denseDijkstra(adjacency [][]int, weight func(u, v int) float64, source int) ([]float64, []int) {
+	dist := make([]float64, len(adjacency))
+	parent := make([]int, len(adjacency))
+	done := make([]bool, len(adjacency))
+	for v := range dist {
+		dist[v] = math.Inf(1)
+		parent[v] = -1
+	}
+	dist[source] = 0
+	for {
+		u := -1
+		for v := range dist {
+			if !done[v] && !math.IsInf(dist[v], 1) && (u < 0 || dist[v] < dist[u]) {
+				u = v
+			}
+		}
+		if u < 0 {
+			return dist, parent
+		}
+		done[u] = true
+		for _, w := range adjacency[u] {
+			if candidate := dist[u] + weight(u, w); candidate < dist[w] {
+				dist[w] = candidate
+				parent[w] = u
+			}
+		}
+	}
+}
+
+// treePath returns the path from v up to the root of a shortest path tree, v first.
+func treePath(parent []int, v int) []int {
+	path := []int{v}
+	for parent[v] >= 0 {
+		v = parent[v]
+		path = append(path, v)
+	}
+	return path
+}
+
+/*
+Girth returns the length of the shortest cycle of the undirected graph.
+
+Parameters:
+- g: The undirected graph.
+
+Returns:
+- The girth of the graph, or 0 if the graph is a forest. Self-loops and parallel edges are ignored.
+
+Description:
+A breadth-first search from every node finds the shortest cycle through it; the
+running time is O(V·E).
+*/
+func Girth(g *UndirectedGraph) int {
+	nodes, _, adjacency := simpleAdjacency(g)
+	girth := 0
+	dist := make([]int, len(nodes))
+	parent := make([]int, len(nodes))
+	for root := range nodes {
+		for v := range dist {
+			dist[v] = -1
+		}
+		dist[root] = 0
+		parent[root] = -1
+		queue := []int{root}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			if girth > 0 && 2*dist[v]+1 >= girth {
+				break
+			}
+			for _, w := range adjacency[v] {
+				if dist[w] < 0 {
+					dist[w] = dist[v] + 1
+					parent[w] = v
+					queue = append(queue, w)
+				} else if parent[v] != w {
+					if length := dist[v] + dist[w] + 1; girth == 0 || length < girth {
+						girth = length
+					}
+				}
+			}
+		}
+	}
+	return girth
+}
+
+/*
+SimpleCycles enumerates the simple cycles of a directed graph.
+
+Parameters:
+- g: The directed graph.
+
+Returns:
+- An iterator following the iter.Seq convention: it calls yield once per elementary cycle, given as nodes in the direction of its edges starting from the smallest node, and stops early as soon as yield returns false.
+
+Description:
+The function implements Johnson's algorithm, which spends O(V+E) time between
+two consecutive cycles. The number of cycles can be exponential, so cycles are
+produced lazily. Self-loops are cycles of length one; parallel edges are
+counted once.
+
+Example:
+
+	g := &DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {2, 0}, {1, 0}})
+	SimpleCycles(g)(func(cycle []Node) bool {
+		fmt.Println(cycle) // [0 1], then [0 1 2]
+		return true
+	})
+*/
+func SimpleCycles(g *DirectedGraph) func(yield func([]Node) bool) {
+	return func(yield func([]Node) bool) {
+		order := sortedNodes(g.Nodes)
+		for i, start := range order {
+			remaining := make(map[Node]bool, len(order)-i)
+			for _, node := range order[i:] {
+				remaining[node] = true
+			}
+			components := StronglyConnectedComponents(g.Subgraph(remaining))
+			var component *DirectedGraph
+			for _, c := range components.ComponentsArray {
+				if c.HasNode(start) {
+					component = c
+					break
+				}
+			}
+			if component == nil || len(component.Edges[start]) == 0 {
+				continue
+			}
+			if !johnsonCircuits(component, start, yield) {
+				return
+			}
+		}
+	}
+}
+
+// johnsonCircuits yields every elementary cycle through start inside a strongly
+// connected component, and returns false if yield asked to stop.
+func johnsonCircuits(component *DirectedGraph, start Node, yield func([]Node) bool) bool {
+	successors := make(map[Node][]Node, len(component.Nodes))
+	for node := range component.Nodes {
+		seen := make(map[Node]bool)
+		for _, successor := range component.Edges[node] {
+			if !seen[successor] {
+				seen[successor] = true
+				successors[node] = append(successors[node], successor)
+			}
+		}
+		sort.Slice(successors[node], func(i, j int) bool { return successors[node][i] < successors[node][j] })
+	}
+
+	blocked := make(map[Node]bool)
+	blockedBy := make(map[Node]map[Node]bool)
+	var unblock func(node Node)
+	unblock = func(node Node) {
+		blocked[node] = false
+		for other := range blockedBy[node] {
+			delete(blockedBy[node], other)
+			if blocked[other] {
+				unblock(other)
+			}
+		}
+	}
+
+	stopped := false
+	var path []Node
+	var circuit func(v Node) bool
+	circuit = func(v Node) bool {
+		found := false
+		path = append(path, v)
+		blocked[v] = true
+		for _, w := range successors[v] {
+			if w == start {
+				found = true
+				if !yield(append([]Node(nil), path...)) {
+					stopped = true
+				}
+			} else if !blocked[w] && circuit(w) {
+				found = true
+			}
+			if stopped {
+				break
+			}
+		}
+		if found {
+			unblock(v)
+		} else {
+			for _, w := range successors[v] {
+				if blockedBy[w] == nil {
+					blockedBy[w] = make(map[Node]bool)
+				}
+				blockedBy[w][v] = true
+			}
+		}
+		path = path[:len(path)-1]
+		return found
+	}
+	circuit(start)
+	return !stopped
+}
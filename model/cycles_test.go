@@ -0,0 +1,238 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+// isCycle reports whether consecutive nodes of cycle, including last to first, are adjacent.
+func isCycle(g *UndirectedGraph, cycle []Node) bool {
+	if len(cycle) < 3 {
+		return false
+	}
+	seen := make(map[Node]bool)
+	for i, node := range cycle {
+		if seen[node] || !g.HasEdge(Edge{Node1: node, Node2: cycle[(i+1)%len(cycle)]}) {
+			return false
+		}
+		seen[node] = true
+	}
+	return true
+}
+
+func TestFindCycle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		graph    *UndirectedGraph
+		hasCycle bool
+	}{
+		{name: "Path graph", graph: PathGraph(6), hasCycle: false},
+		{name: "Star graph", graph: StarGraph(5), hasCycle: false},
+		{name: "Null graph", graph: NullGraph(), hasCycle: false},
+		{name: "Cycle graph", graph: CycleGraph(5), hasCycle: true},
+		{name: "Lollipop graph", graph: LollipopGraph(3, 4), hasCycle: true},
+		{name: "Ladder graph", graph: LadderGraph(3), hasCycle: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cycle := FindCycle(tc.graph)
+			if HasCycle(tc.graph) != tc.hasCycle {
+				t.Fatalf("Expected HasCycle to be %v", tc.hasCycle)
+			}
+			if tc.hasCycle && !isCycle(tc.graph, cycle) {
+				t.Errorf("%v is not a cycle", cycle)
+			}
+			if !tc.hasCycle && cycle != nil {
+				t.Errorf("Expected no cycle, but got %v", cycle)
+			}
+		})
+	}
+}
+
+func TestFindDirectedCycle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		edges    [][2]int
+		expected []Node
+	}{
+		{name: "Acyclic", edges: [][2]int{{0, 1}, {0, 2}, {1, 2}, {2, 3}}, expected: nil},
+		{name: "Triangle", edges: [][2]int{{0, 1}, {1, 2}, {2, 0}}, expected: []Node{0, 1, 2}},
+		{name: "Self-loop", edges: [][2]int{{0, 1}, {1, 1}}, expected: []Node{1}},
+		{name: "Cycle after a tail", edges: [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 1}}, expected: []Node{1, 2, 3}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := &DirectedGraph{}
+			g.AddEdgesFromIntTupleList(tc.edges)
+			cycle := FindDirectedCycle(g)
+			if !sliceEqual(cycle, tc.expected) || HasDirectedCycle(g) != (tc.expected != nil) {
+				t.Errorf("Expected %v, but got %v", tc.expected, cycle)
+			}
+		})
+	}
+}
+
+func TestCycleBasis(t *testing.T) {
+	testCases := []struct {
+		name      string
+		graph     *UndirectedGraph
+		dimension int
+	}{
+		{name: "Path graph", graph: PathGraph(5), dimension: 0},
+		{name: "Cycle graph", graph: CycleGraph(6), dimension: 1},
+		{name: "Complete graph", graph: CompleteGraph(5), dimension: 6},
+		{name: "Ladder graph", graph: LadderGraph(4), dimension: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			basis := CycleBasis(tc.graph)
+			if len(basis) != tc.dimension {
+				t.Fatalf("Expected %d cycles, but got %v", tc.dimension, basis)
+			}
+			for _, cycle := range basis {
+				if !isCycle(tc.graph, cycle) {
+					t.Errorf("%v is not a cycle", cycle)
+				}
+			}
+		})
+	}
+}
+
+func TestMinimumCycleBasis(t *testing.T) {
+	cube := &UndirectedGraph{}
+	cube.AddEdgesFromIntTupleList([][2]int{
+		{0, 1}, {1, 3}, {3, 2}, {2, 0}, {4, 5}, {5, 7}, {7, 6}, {6, 4}, {0, 4}, {1, 5}, {2, 6}, {3, 7},
+	})
+
+	testCases := []struct {
+		name     string
+		graph    *UndirectedGraph
+		weights  map[Edge]float64
+		expected []int
+	}{
+		{name: "Tree", graph: StarGraph(5), expected: nil},
+		{name: "Cube", graph: cube, expected: []int{4, 4, 4, 4, 4}},
+		{name: "Circular ladder", graph: circularLadder(5), expected: []int{4, 4, 4, 4, 4, 5}},
+		{name: "Complete graph", graph: CompleteGraph(4), expected: []int{3, 3, 3}},
+		{
+			name:     "Weighted cycle",
+			graph:    CycleGraph(4),
+			weights:  map[Edge]float64{{Node1: 0, Node2: 1}: 10},
+			expected: []int{4},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			basis := MinimumCycleBasis(tc.graph, tc.weights)
+			var lengths []int
+			for _, cycle := range basis {
+				if !isCycle(tc.graph, cycle) {
+					t.Errorf("%v is not a cycle", cycle)
+				}
+				lengths = append(lengths, len(cycle))
+			}
+			sort.Ints(lengths)
+			if fmt.Sprint(lengths) != fmt.Sprint(tc.expected) {
+				t.Errorf("Expected cycle lengths %v, but got %v", tc.expected, basis)
+			}
+		})
+	}
+}
+
+func TestMinimumCycleBasis_Weighted(t *testing.T) {
+	// Two triangles sharing the heavy edge 1 - 2: the outer 4-cycle (weight 4)
+	// and either triangle (weight 12) form the minimum basis
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {1, 2}, {1, 3}, {2, 3}})
+	weights := map[Edge]float64{{Node1: 1, Node2: 2}: 10}
+	basis := MinimumCycleBasis(g, weights)
+	total := 0.0
+	for _, cycle := range basis {
+		for i := range cycle {
+			total += undirectedEdgeWeight(weights, Edge{Node1: cycle[i], Node2: cycle[(i+1)%len(cycle)]})
+		}
+	}
+	if len(basis) != 2 || total != 16 {
+		t.Errorf("Expected two cycles of total weight 16, but got %v (%v)", basis, total)
+	}
+}
+
+func TestGirth(t *testing.T) {
+	testCases := []struct {
+		name     string
+		graph    *UndirectedGraph
+		expected int
+	}{
+		{name: "Tree", graph: PathGraph(5), expected: 0},
+		{name: "Cycle graph", graph: CycleGraph(7), expected: 7},
+		{name: "Ladder graph", graph: LadderGraph(4), expected: 4},
+		{name: "Lollipop graph", graph: LollipopGraph(4, 3), expected: 3},
+		{name: "Circular ladder", graph: circularLadder(3), expected: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if girth := Girth(tc.graph); girth != tc.expected {
+				t.Errorf("Expected %d, but got %d", tc.expected, girth)
+			}
+		})
+	}
+}
+
+func TestSimpleCycles(t *testing.T) {
+	complete := &DirectedGraph{}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if i != j {
+				complete.AddEdge(Edge{Node1: Node(i), Node2: Node(j)})
+			}
+		}
+	}
+	small := &DirectedGraph{}
+	small.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {2, 0}, {1, 0}, {2, 2}, {2, 3}})
+
+	testCases := []struct {
+		name     string
+		graph    *DirectedGraph
+		expected []string
+		count    int
+	}{
+		{name: "Small graph", graph: small, expected: []string{"[0 1 2]", "[0 1]", "[2]"}, count: 3},
+		{name: "Complete digraph", graph: complete, count: 20},
+		{name: "Acyclic", graph: CompleteGraph(3).ToDirected().Subgraph(map[Node]bool{0: true}), count: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cycles []string
+			SimpleCycles(tc.graph)(func(cycle []Node) bool {
+				cycles = append(cycles, fmt.Sprint(cycle))
+				return true
+			})
+			sort.Strings(cycles)
+			if len(cycles) != tc.count {
+				t.Fatalf("Expected %d cycles, but got %v", tc.count, cycles)
+			}
+			if tc.expected != nil && fmt.Sprint(cycles) != fmt.Sprint(tc.expected) {
+				t.Errorf("Expected %v, but got %v", tc.expected, cycles)
+			}
+		})
+	}
+}
+
+func TestSimpleCycles_StopsEarly(t *testing.T) {
+	g := CompleteGraph(5).ToDirected()
+	calls := 0
+	SimpleCycles(g)(func(cycle []Node) bool {
+		calls++
+		return calls < 4
+	})
+	if calls != 4 {
+		t.Errorf("Expected the enumeration to stop after 4 cycles, but got %d", calls)
+	}
+}
@@ -0,0 +1,424 @@
+package model
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// nodeHeap is a min-heap of nodes implementing heap.Interface.
+type nodeHeap []Node
+
+func (h nodeHeap) Len() int           { return len(h) }
+func (h nodeHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h nodeHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *nodeHeap) Push(x any) { *h = append(*h, x.(Node)) }
+
+func (h *nodeHeap) Pop() any {
+	old := *h
+	node := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return node
+}
+
+// IsDirectedAcyclicGraph reports whether the directed graph has no cycle, self-loops included.
+func IsDirectedAcyclicGraph(g *DirectedGraph) bool {
+	return !HasDirectedCycle(g)
+}
+
+/*
+TopologicalSort returns the nodes of a directed acyclic graph so that every edge goes from an earlier node to a later one.
+
+Parameters:
+- g: The directed graph.
+
+Returns:
+- order: The lexicographically smallest topological ordering of the nodes.
+- error: If the graph contains a cycle.
+
+Description:
+The function implements Kahn's algorithm: it repeatedly removes a node without
+remaining predecessors, always the smallest available one, so the result is
+deterministic. It runs in O((V+E) log V).
+
+Example:
+
+	g := &DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{2, 0}, {2, 1}, {0, 1}})
+	order, _ := TopologicalSort(g)
+	fmt.Println(order) // Output: [2 0 1]
+*/
+func TopologicalSort(g *DirectedGraph) ([]Node, error) {
+	inDegree := make(map[Node]int, len(g.Nodes))
+	for _, successors := range g.Edges {
+		for _, successor := range successors {
+			inDegree[successor]++
+		}
+	}
+
+	ready := &nodeHeap{}
+	for node := range g.Nodes {
+		if inDegree[node] == 0 {
+			*ready = append(*ready, node)
+		}
+	}
+	heap.Init(ready)
+
+	order := make([]Node, 0, len(g.Nodes))
+	for ready.Len() > 0 {
+		node := heap.Pop(ready).(Node)
+		order = append(order, node)
+		for _, successor := range g.Edges[node] {
+			inDegree[successor]--
+			if inDegree[successor] == 0 {
+				heap.Push(ready, successor)
+			}
+		}
+	}
+
+	if len(order) != len(g.Nodes) {
+		return nil, fmt.Errorf("graph contains a cycle")
+	}
+	return order, nil
+}
+
+/*
+DFSTopologicalSort returns a topological ordering of a directed acyclic graph computed by depth-first search.
+
+Parameters:
+- g: The directed graph.
+
+Returns:
+- order: The nodes in reverse depth-first postorder, roots explored in increasing order.
+- error: If the graph contains a cycle.
+*/
+func DFSTopologicalSort(g *DirectedGraph) ([]Node, error) {
+	const (
+		unvisited = iota
+		onStack
+		finished
+	)
+	state := make(map[Node]int, len(g.Nodes))
+	next := make(map[Node]int, len(g.Nodes))
+	postorder := make([]Node, 0, len(g.Nodes))
+
+	for _, root := range sortedNodes(g.Nodes) {
+		if state[root] != unvisited {
+			continue
+		}
+		state[root] = onStack
+		stack := []Node{root}
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			if next[node] == len(g.Edges[node]) {
+				state[node] = finished
+				postorder = append(postorder, node)
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			successor := g.Edges[node][next[node]]
+			next[node]++
+			switch state[successor] {
+			case onStack:
+				return nil, fmt.Errorf("graph contains a cycle through %d and %d", node, successor)
+			case unvisited:
+				state[successor] = onStack
+				stack = append(stack, successor)
+			}
+		}
+	}
+
+	for i, j := 0, len(postorder)-1; i < j; i, j = i+1, j-1 {
+		postorder[i], postorder[j] = postorder[j], postorder[i]
+	}
+	return postorder, nil
+}
+
+/*
+AllTopologicalSorts enumerates every topological ordering of a directed acyclic graph.
+
+Parameters:
+- g: The directed graph.
+
+Returns:
+- An iterator following the iter.Seq convention: it calls yield once per ordering, in lexicographic order, and stops early as soon as yield returns false. The number of orderings can be factorial in the number of nodes, so they are produced lazily. Nothing is yielded if the graph contains a cycle.
+
+Example:
+
+	g := &DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 2}, {1, 2}})
+	AllTopologicalSorts(g)(func(order []Node) bool {
+		fmt.Println(order) // [0 1 2], [1 0 2]
+		return true
+	})
+*/
+func AllTopologicalSorts(g *DirectedGraph) func(yield func([]Node) bool) {
+	return func(yield func([]Node) bool) {
+		if !IsDirectedAcyclicGraph(g) {
+			return
+		}
+		inDegree := make(map[Node]int, len(g.Nodes))
+		for _, successors := range g.Edges {
+			for _, successor := range successors {
+				inDegree[successor]++
+			}
+		}
+		nodes := sortedNodes(g.Nodes)
+		placed := make(map[Node]bool, len(nodes))
+		order := make([]Node, 0, len(nodes))
+
+		var extend func() bool
+		extend = func() bool {
+			if len(order) == len(nodes) {
+				return yield(append([]Node(nil), order...))
+			}
+			for _, node := range nodes {
+				if placed[node] || inDegree[node] > 0 {
+					continue
+				}
+				placed[node] = true
+				order = append(order, node)
+				for _, successor := range g.Edges[node] {
+					inDegree[successor]--
+				}
+				keepGoing := extend()
+				for _, successor := range g.Edges[node] {
+					inDegree[successor]++
+				}
+				order = order[:len(order)-1]
+				placed[node] = false
+				if !keepGoing {
+					return false
+				}
+			}
+			return true
+		}
+		extend()
+	}
+}
+
+/*
+DAGLongestPath returns a path of maximum total weight in a directed acyclic graph.
+
+Parameters:
+- g: The directed graph.
+- weights: The weight of every edge; missing edges (or a nil map) weigh 1.
+
+Returns:
+- path: The nodes of the path in order; a single node when the graph has no edge and nil for the null graph.
+- length: The total weight of the path.
+- error: If the graph contains a cycle.
+
+Description:
+The function relaxes the edges in topological order, which takes O(V+E). With
+unit weights the path is a critical path of the dependency graph.
+*/
+func DAGLongestPath(g *DirectedGraph, weights map[Edge]float64) ([]Node, float64, error) {
+	order, err := TopologicalSort(g)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(order) == 0 {
+		return nil, 0, nil
+	}
+
+	distance := make(map[Node]float64, len(order))
+	parent := make(map[Node]Node, len(order))
+	for _, node := range order {
+		// Starting a new path is better than arriving with a negative weight
+		if distance[node] < 0 {
+			distance[node] = 0
+			delete(parent, node)
+		}
+		for _, successor := range g.Edges[node] {
+			candidate := distance[node] + edgeCapacity(weights, Edge{Node1: node, Node2: successor})
+			if _, reached := parent[successor]; !reached || candidate > distance[successor] {
+				distance[successor] = candidate
+				parent[successor] = node
+			}
+		}
+	}
+
+	end, best := order[0], math.Inf(-1)
+	for _, node := range order {
+		if distance[node] > best {
+			end, best = node, distance[node]
+		}
+	}
+	path := []Node{end}
+	for {
+		previous, ok := parent[path[len(path)-1]]
+		if !ok {
+			break
+		}
+		path = append(path, previous)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, best, nil
+}
+
+// reachable returns the nodes reachable from source by a non-empty path.
+func reachable(successors map[Node][]Node, source Node) map[Node]bool {
+	seen := make(map[Node]bool)
+	stack := []Node{source}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, successor := range successors[node] {
+			if !seen[successor] {
+				seen[successor] = true
+				stack = append(stack, successor)
+			}
+		}
+	}
+	return seen
+}
+
+// Descendants returns the nodes reachable from node by a non-empty path. The node itself is included only if it lies on a cycle.
+func Descendants(g *DirectedGraph, node Node) map[Node]bool {
+	if !g.HasNode(node) {
+		return map[Node]bool{}
+	}
+	return reachable(g.Edges, node)
+}
+
+// Ancestors returns the nodes from which node is reachable by a non-empty path. The node itself is included only if it lies on a cycle.
+func Ancestors(g *DirectedGraph, node Node) map[Node]bool {
+	if !g.HasNode(node) {
+		return map[Node]bool{}
+	}
+	return reachable(g.Predecessors(), node)
+}
+
+/*
+TransitiveClosure returns the transitive closure of the directed graph.
+
+Parameters:
+- g: The directed graph.
+
+Returns:
+- closure: A graph on the same nodes with an edge u -> v whenever v is reachable from u by a non-empty path. Nodes lying on a cycle get a self-loop.
+
+Example:
+
+	g := &DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}})
+	fmt.Println(TransitiveClosure(g).HasEdge(Edge{Node1: 0, Node2: 2})) // Output: true
+*/
+func TransitiveClosure(g *DirectedGraph) *DirectedGraph {
+	closure := &DirectedGraph{
+		Nodes: make(map[Node]bool, len(g.Nodes)),
+		Edges: make(map[Node][]Node),
+	}
+	for _, node := range sortedNodes(g.Nodes) {
+		closure.AddNode(node)
+		for _, descendant := range sortedNodes(reachable(g.Edges, node)) {
+			closure.AddEdge(Edge{Node1: node, Node2: descendant})
+		}
+	}
+	return closure
+}
+
+/*
+TransitiveReduction returns the transitive reduction of a directed acyclic graph.
+
+Parameters:
+- g: The directed graph.
+
+Returns:
+- reduction: The graph with the fewest edges having the same reachability as g: an edge u -> v is kept only if v cannot be reached from u through another successor. Parallel edges are merged.
+- error: If the graph contains a cycle, for which the reduction is not unique.
+*/
+func TransitiveReduction(g *DirectedGraph) (*DirectedGraph, error) {
+	if !IsDirectedAcyclicGraph(g) {
+		return nil, fmt.Errorf("transitive reduction is only defined for directed acyclic graphs")
+	}
+
+	reduction := &DirectedGraph{
+		Nodes: make(map[Node]bool, len(g.Nodes)),
+		Edges: make(map[Node][]Node),
+	}
+	descendants := make(map[Node]map[Node]bool, len(g.Nodes))
+	for _, node := range sortedNodes(g.Nodes) {
+		reduction.AddNode(node)
+		successors := make(map[Node]bool)
+		for _, successor := range g.Edges[node] {
+			successors[successor] = true
+		}
+		indirect := make(map[Node]bool)
+		for successor := range successors {
+			if descendants[successor] == nil {
+				descendants[successor] = reachable(g.Edges, successor)
+			}
+			for descendant := range descendants[successor] {
+				indirect[descendant] = true
+			}
+		}
+		for _, successor := range sortedNodes(successors) {
+			if !indirect[successor] {
+				reduction.AddEdge(Edge{Node1: node, Node2: successor})
+			}
+		}
+	}
+	return reduction, nil
+}
+
+/*
+LowestCommonAncestors returns the lowest common ancestors of two nodes of a directed acyclic graph.
+
+Parameters:
+- g: The directed graph.
+- node1, node2: The query nodes.
+
+Returns:
+- ancestors: The common ancestors of the two nodes that have no descendant which is itself a common ancestor, in increasing order. Every node counts as its own ancestor, so the result is [u] when u is an ancestor of v. It is empty when the nodes share no ancestor. In a tree the result has exactly one node.
+- error: If the graph contains a cycle or one of the nodes is not in the graph.
+
+Example:
+
+	g := &DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {1, 3}, {1, 4}})
+	lca, _ := LowestCommonAncestors(g, 3, 4)
+	fmt.Println(lca) // Output: [1]
+*/
+func LowestCommonAncestors(g *DirectedGraph, node1 Node, node2 Node) ([]Node, error) {
+	if !g.HasNode(node1) || !g.HasNode(node2) {
+		return nil, fmt.Errorf("nodes %d and %d must both be in the graph", node1, node2)
+	}
+	if !IsDirectedAcyclicGraph(g) {
+		return nil, fmt.Errorf("lowest common ancestors are only defined for directed acyclic graphs")
+	}
+
+	predecessors := g.Predecessors()
+	ancestors1 := reachable(predecessors, node1)
+	ancestors1[node1] = true
+	ancestors2 := reachable(predecessors, node2)
+	ancestors2[node2] = true
+
+	common := make(map[Node]bool)
+	for node := range ancestors1 {
+		if ancestors2[node] {
+			common[node] = true
+		}
+	}
+
+	var lowest []Node
+	for node := range common {
+		isLowest := true
+		for _, successor := range g.Edges[node] {
+			if common[successor] {
+				isLowest = false
+				break
+			}
+		}
+		if isLowest {
+			lowest = append(lowest, node)
+		}
+	}
+	sort.Slice(lowest, func(i, j int) bool { return lowest[i] < lowest[j] })
+	return lowest, nil
+}
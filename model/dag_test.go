@@ -0,0 +1,210 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+)
+
+// buildDependencyGraph returns a small build graph: 0 and 1 are sources, 5 is the final target.
+func buildDependencyGraph() *DirectedGraph {
+	g := &DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 2}, {1, 2}, {1, 3}, {2, 4}, {3, 4}, {4, 5}, {0, 5}})
+	return g
+}
+
+// checkTopologicalOrder verifies that order lists every node once and respects every edge.
+func checkTopologicalOrder(t *testing.T, g *DirectedGraph, order []Node) {
+	t.Helper()
+	position := make(map[Node]int, len(order))
+	for idx, node := range order {
+		position[node] = idx
+	}
+	if len(position) != len(g.Nodes) || len(order) != len(g.Nodes) {
+		t.Fatalf("Order %v does not list every node exactly once", order)
+	}
+	for _, edge := range g.GetEdgeTuples() {
+		if position[edge.Node1] >= position[edge.Node2] {
+			t.Errorf("Edge %v is not respected by %v", edge, order)
+		}
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	g := buildDependencyGraph()
+
+	order, err := TopologicalSort(g)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := []Node{0, 1, 2, 3, 4, 5}; !sliceEqual(order, expected) {
+		t.Errorf("Expected %v, but got %v", expected, order)
+	}
+
+	order, err = DFSTopologicalSort(g)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	checkTopologicalOrder(t, g, order)
+
+	cyclic := &DirectedGraph{}
+	cyclic.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {2, 1}})
+	if _, err := TopologicalSort(cyclic); err == nil {
+		t.Errorf("Expected an error from Kahn's algorithm on a cyclic graph")
+	}
+	if _, err := DFSTopologicalSort(cyclic); err == nil {
+		t.Errorf("Expected an error from the depth-first sort on a cyclic graph")
+	}
+	if IsDirectedAcyclicGraph(cyclic) || !IsDirectedAcyclicGraph(g) {
+		t.Errorf("IsDirectedAcyclicGraph returned a wrong answer")
+	}
+}
+
+func TestAllTopologicalSorts(t *testing.T) {
+	diamond := &DirectedGraph{}
+	diamond.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}})
+	isolated := &DirectedGraph{}
+	isolated.AddNodes([]Node{0, 1, 2})
+	cyclic := &DirectedGraph{}
+	cyclic.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 0}})
+
+	testCases := []struct {
+		name     string
+		graph    *DirectedGraph
+		expected string
+	}{
+		{name: "Diamond", graph: diamond, expected: "[[0 1 2 3] [0 2 1 3]]"},
+		{name: "Isolated nodes", graph: isolated, expected: "[[0 1 2] [0 2 1] [1 0 2] [1 2 0] [2 0 1] [2 1 0]]"},
+		{name: "Cyclic", graph: cyclic, expected: "[]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			orders := [][]Node{}
+			AllTopologicalSorts(tc.graph)(func(order []Node) bool {
+				orders = append(orders, order)
+				return true
+			})
+			if fmt.Sprint(orders) != tc.expected {
+				t.Errorf("Expected %v, but got %v", tc.expected, orders)
+			}
+		})
+	}
+
+	calls := 0
+	AllTopologicalSorts(isolated)(func(order []Node) bool {
+		calls++
+		return calls < 2
+	})
+	if calls != 2 {
+		t.Errorf("Expected the enumeration to stop after 2 orderings, but got %d", calls)
+	}
+}
+
+func TestDAGLongestPath(t *testing.T) {
+	g := buildDependencyGraph()
+
+	path, length, err := DAGLongestPath(g, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !sliceEqual(path, []Node{0, 2, 4, 5}) || length != 3 {
+		t.Errorf("Expected [0 2 4 5] of length 3, but got %v of length %v", path, length)
+	}
+
+	weights := map[Edge]float64{{Node1: 0, Node2: 5}: 10, {Node1: 1, Node2: 3}: -4}
+	path, length, _ = DAGLongestPath(g, weights)
+	if !sliceEqual(path, []Node{0, 5}) || length != 10 {
+		t.Errorf("Expected [0 5] of length 10, but got %v of length %v", path, length)
+	}
+
+	negative := &DirectedGraph{}
+	negative.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}})
+	path, length, _ = DAGLongestPath(negative, map[Edge]float64{{Node1: 0, Node2: 1}: -5})
+	if !sliceEqual(path, []Node{1, 2}) || length != 1 {
+		t.Errorf("Expected [1 2] of length 1, but got %v of length %v", path, length)
+	}
+}
+
+func TestAncestorsDescendants(t *testing.T) {
+	g := buildDependencyGraph()
+	if ancestors := sortedNodes(Ancestors(g, 4)); !sliceEqual(ancestors, []Node{0, 1, 2, 3}) {
+		t.Errorf("Expected ancestors [0 1 2 3], but got %v", ancestors)
+	}
+	if descendants := sortedNodes(Descendants(g, 1)); !sliceEqual(descendants, []Node{2, 3, 4, 5}) {
+		t.Errorf("Expected descendants [2 3 4 5], but got %v", descendants)
+	}
+	if len(Descendants(g, 5)) != 0 || len(Ancestors(g, 42)) != 0 {
+		t.Errorf("Expected no descendants of a sink nor ancestors of a missing node")
+	}
+}
+
+func TestTransitiveClosureAndReduction(t *testing.T) {
+	g := buildDependencyGraph()
+
+	closure := TransitiveClosure(g)
+	if closure.NumberOfEdges() != 12 {
+		t.Errorf("Expected 12 edges in the closure, but got %v", closure)
+	}
+	for _, edge := range []Edge{{Node1: 0, Node2: 4}, {Node1: 1, Node2: 5}, {Node1: 3, Node2: 5}} {
+		if !closure.HasEdge(edge) {
+			t.Errorf("Expected edge %v in the closure", edge)
+		}
+	}
+
+	reduction, err := TransitiveReduction(closure)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := &DirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 2}, {1, 2}, {1, 3}, {2, 4}, {3, 4}, {4, 5}})
+	if !reduction.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, reduction)
+	}
+
+	cyclic := &DirectedGraph{}
+	cyclic.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 0}})
+	if _, err := TransitiveReduction(cyclic); err == nil {
+		t.Errorf("Expected an error for a cyclic graph")
+	}
+	if !TransitiveClosure(cyclic).HasEdge(Edge{Node1: 0, Node2: 0}) {
+		t.Errorf("Expected a self-loop on a node lying on a cycle")
+	}
+}
+
+func TestLowestCommonAncestors(t *testing.T) {
+	tree := &DirectedGraph{}
+	tree.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {1, 3}, {1, 4}, {2, 5}})
+	criss := &DirectedGraph{}
+	criss.AddEdgesFromIntTupleList([][2]int{{0, 2}, {0, 3}, {1, 2}, {1, 3}})
+
+	testCases := []struct {
+		name     string
+		graph    *DirectedGraph
+		node1    Node
+		node2    Node
+		expected []Node
+	}{
+		{name: "Siblings", graph: tree, node1: 3, node2: 4, expected: []Node{1}},
+		{name: "Cousins", graph: tree, node1: 3, node2: 5, expected: []Node{0}},
+		{name: "Ancestor of the other", graph: tree, node1: 1, node2: 4, expected: []Node{1}},
+		{name: "Same node", graph: tree, node1: 5, node2: 5, expected: []Node{5}},
+		{name: "Several lowest ancestors", graph: criss, node1: 2, node2: 3, expected: []Node{0, 1}},
+		{name: "No common ancestor", graph: criss, node1: 0, node2: 1, expected: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lca, err := LowestCommonAncestors(tc.graph, tc.node1, tc.node2)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !sliceEqual(lca, tc.expected) {
+				t.Errorf("Expected %v, but got %v", tc.expected, lca)
+			}
+		})
+	}
+
+	if _, err := LowestCommonAncestors(tree, 0, 42); err == nil {
+		t.Errorf("Expected an error for a missing node")
+	}
+}
@@ -0,0 +1,293 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DirectedGraph is a graph whose edges have an orientation. Edges maps every
+// node to the list of its successors, i.e. an edge {Node1: u, Node2: v} is
+// stored only in Edges[u].
+type DirectedGraph struct {
+	Nodes map[Node]bool
+	Edges map[Node][]Node
+}
+
+// DirectedComponents mirrors Components for directed graphs.
+type DirectedComponents struct {
+	ComponentsArray     []*DirectedGraph
+	BiggestComponentIdx int
+}
+
+func (c *DirectedComponents) AddComponent(component *DirectedGraph) {
+	c.ComponentsArray = append(c.ComponentsArray, component)
+
+	if c.BiggestComponentIdx < 0 {
+		c.BiggestComponentIdx = 0
+	} else if len(c.ComponentsArray[c.BiggestComponentIdx].Nodes) < len(component.Nodes) {
+		c.BiggestComponentIdx = len(c.ComponentsArray) - 1
+	}
+}
+
+func (c *DirectedComponents) GetBiggestComponent() *DirectedGraph {
+	if c.BiggestComponentIdx >= 0 {
+		return c.ComponentsArray[c.BiggestComponentIdx]
+	}
+	return nil
+}
+
+func (g *DirectedGraph) String() string {
+	var str strings.Builder
+
+	str.WriteString("Nodes:\n")
+	for node := range g.Nodes {
+		str.WriteString(fmt.Sprintf("%d: true\t", node))
+	}
+
+	str.WriteString("\nEdges:\n")
+	for node, edges := range g.Edges {
+		str.WriteString(fmt.Sprintf("%d -> %v\n", node, edges))
+	}
+
+	return str.String()
+}
+
+// Equals reports whether both graphs have the same nodes and the same successor lists.
+func (g *DirectedGraph) Equals(other *DirectedGraph) bool {
+	if len(g.Nodes) != len(other.Nodes) {
+		return false
+	}
+
+	for node := range g.Nodes {
+		if !other.Nodes[node] {
+			return false
+		}
+	}
+
+	for node, edges := range g.Edges {
+		otherEdges := other.Edges[node]
+		if len(edges) != len(otherEdges) {
+			return false
+		}
+
+		for _, edge := range edges {
+			found := false
+			for _, otherEdge := range otherEdges {
+				if edge == otherEdge {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+/*
+AddEdge adds a directed edge from edge.Node1 to edge.Node2.
+
+Description:
+Both endpoints are added to the graph if they do not already exist. Only the
+successor list of edge.Node1 is updated.
+
+Example:
+
+	directedGraph := DirectedGraph{}
+	directedGraph.AddEdge(Edge{Node1: 1, Node2: 2})
+
+	fmt.Println(directedGraph.Edges) // Output: map[1:[2]]
+*/
+func (g *DirectedGraph) AddEdge(edge Edge) {
+	if g.Edges == nil {
+		g.Edges = make(map[Node][]Node)
+	}
+
+	g.AddNode(edge.Node1)
+	g.AddNode(edge.Node2)
+
+	g.Edges[edge.Node1] = append(g.Edges[edge.Node1], edge.Node2)
+}
+
+// AddNode adds a node to the DirectedGraph.
+func (g *DirectedGraph) AddNode(node Node) {
+	if g.Nodes == nil {
+		g.Nodes = make(map[Node]bool)
+	}
+
+	g.Nodes[node] = true
+}
+
+// AddNodes adds multiple nodes to the DirectedGraph.
+func (g *DirectedGraph) AddNodes(nodes []Node) {
+	for _, node := range nodes {
+		g.AddNode(node)
+	}
+}
+
+func (g *DirectedGraph) AddEdgesFromIntTupleList(edges [][2]int) {
+	for _, nodes := range edges {
+		g.AddEdge(Edge{Node(nodes[0]), Node(nodes[1])})
+	}
+}
+
+// HasNode checks if the DirectedGraph contains a specific node.
+func (g *DirectedGraph) HasNode(node Node) bool {
+	return g.Nodes[node]
+}
+
+// HasEdge checks if the DirectedGraph contains the edge edge.Node1 -> edge.Node2.
+func (g *DirectedGraph) HasEdge(edge Edge) bool {
+	for _, successor := range g.Edges[edge.Node1] {
+		if successor == edge.Node2 {
+			return true
+		}
+	}
+	return false
+}
+
+// OutDegree returns the number of edges leaving the node.
+func (g *DirectedGraph) OutDegree(node Node) int {
+	if !g.Nodes[node] {
+		return 0
+	}
+	return len(g.Edges[node])
+}
+
+// InDegree returns the number of edges entering the node.
+func (g *DirectedGraph) InDegree(node Node) int {
+	if !g.Nodes[node] {
+		return 0
+	}
+	degree := 0
+	for _, successors := range g.Edges {
+		for _, successor := range successors {
+			if successor == node {
+				degree++
+			}
+		}
+	}
+	return degree
+}
+
+// NodeDegree returns the total degree (in-degree plus out-degree) of the node.
+func (g *DirectedGraph) NodeDegree(node Node) int {
+	return g.InDegree(node) + g.OutDegree(node)
+}
+
+// Predecessors returns, for every node of the graph, the list of nodes with an edge pointing to it.
+func (g *DirectedGraph) Predecessors() map[Node][]Node {
+	predecessors := make(map[Node][]Node, len(g.Nodes))
+	for node, successors := range g.Edges {
+		for _, successor := range successors {
+			predecessors[successor] = append(predecessors[successor], node)
+		}
+	}
+	return predecessors
+}
+
+// GetEdgeTuples returns a slice of Edge representing all the edges in the DirectedGraph.
+func (g *DirectedGraph) GetEdgeTuples() []Edge {
+	var edges []Edge
+	for node1, array := range g.Edges {
+		for _, node2 := range array {
+			edges = append(edges, Edge{node1, node2})
+		}
+	}
+	return edges
+}
+
+// NumberOfEdges returns the total number of edges in the directed graph.
+func (g *DirectedGraph) NumberOfEdges() int {
+	totalEdges := 0
+	for _, successors := range g.Edges {
+		totalEdges += len(successors)
+	}
+	return totalEdges
+}
+
+// RemoveEdge removes the directed edge edge.Node1 -> edge.Node2.
+func (g *DirectedGraph) RemoveEdge(edge Edge) {
+	if len(g.Edges[edge.Node1]) > 0 {
+		g.Edges[edge.Node1] = DeleteFromSlice(g.Edges[edge.Node1], edge.Node2)
+	}
+}
+
+// RemoveNode removes a node from the DirectedGraph together with all incoming and outgoing edges.
+func (g *DirectedGraph) RemoveNode(node Node) {
+	delete(g.Nodes, node)
+
+	for source, successors := range g.Edges {
+		g.Edges[source] = DeleteFromSlice(successors, node)
+	}
+
+	delete(g.Edges, node)
+}
+
+// Reverse returns a new DirectedGraph with every edge flipped.
+func (g *DirectedGraph) Reverse() *DirectedGraph {
+	reversed := &DirectedGraph{
+		Nodes: make(map[Node]bool, len(g.Nodes)),
+		Edges: make(map[Node][]Node),
+	}
+	for node := range g.Nodes {
+		reversed.AddNode(node)
+	}
+	for node, successors := range g.Edges {
+		for _, successor := range successors {
+			reversed.AddEdge(Edge{Node1: successor, Node2: node})
+		}
+	}
+	return reversed
+}
+
+// ToUndirected returns the underlying undirected graph, where every directed
+// edge becomes an undirected one. Reciprocal edges u -> v and v -> u collapse
+// into a single undirected edge.
+func (g *DirectedGraph) ToUndirected() *UndirectedGraph {
+	undirected := &UndirectedGraph{
+		Nodes: make(map[Node]bool, len(g.Nodes)),
+		Edges: make(map[Node][]Node),
+	}
+	for node := range g.Nodes {
+		undirected.AddNode(node)
+	}
+	seen := make(map[Edge]bool)
+	for node, successors := range g.Edges {
+		for _, successor := range successors {
+			edge := Edge{Node1: node, Node2: successor}
+			if node > successor {
+				edge = Edge{Node1: successor, Node2: node}
+			}
+			if seen[edge] {
+				continue
+			}
+			seen[edge] = true
+			undirected.AddEdge(edge)
+		}
+	}
+	return undirected
+}
+
+// Subgraph returns the subgraph induced by the given nodes.
+func (g *DirectedGraph) Subgraph(nodes map[Node]bool) *DirectedGraph {
+	subgraph := &DirectedGraph{
+		Nodes: make(map[Node]bool, len(nodes)),
+		Edges: make(map[Node][]Node),
+	}
+	for node := range nodes {
+		if !g.Nodes[node] {
+			continue
+		}
+		subgraph.AddNode(node)
+		for _, successor := range g.Edges[node] {
+			if nodes[successor] {
+				subgraph.AddEdge(Edge{Node1: node, Node2: successor})
+			}
+		}
+	}
+	return subgraph
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDirectedGraph_AddEdge(t *testing.T) {
+	graph := DirectedGraph{}
+	graph.AddEdge(Edge{Node1: 1, Node2: 2})
+	graph.AddEdge(Edge{Node1: 2, Node2: 3})
+
+	expectedEdges := map[Node][]Node{
+		1: {2},
+		2: {3},
+	}
+	if !reflect.DeepEqual(graph.Edges, expectedEdges) {
+		t.Errorf("Expected %v, but got %v", expectedEdges, graph.Edges)
+	}
+
+	expectedNodes := map[Node]bool{1: true, 2: true, 3: true}
+	if !reflect.DeepEqual(graph.Nodes, expectedNodes) {
+		t.Errorf("Expected %v, but got %v", expectedNodes, graph.Nodes)
+	}
+}
+
+func TestDirectedGraph_Degrees(t *testing.T) {
+	graph := DirectedGraph{}
+	graph.AddEdgesFromIntTupleList([][2]int{{1, 2}, {3, 2}, {2, 4}})
+
+	if graph.InDegree(2) != 2 {
+		t.Errorf("Expected in-degree 2, but got %d", graph.InDegree(2))
+	}
+	if graph.OutDegree(2) != 1 {
+		t.Errorf("Expected out-degree 1, but got %d", graph.OutDegree(2))
+	}
+	if graph.NodeDegree(2) != 3 {
+		t.Errorf("Expected degree 3, but got %d", graph.NodeDegree(2))
+	}
+	if graph.NumberOfEdges() != 3 {
+		t.Errorf("Expected 3 edges, but got %d", graph.NumberOfEdges())
+	}
+}
+
+func TestDirectedGraph_RemoveNode(t *testing.T) {
+	graph := DirectedGraph{}
+	graph.AddEdgesFromIntTupleList([][2]int{{1, 2}, {2, 3}, {3, 1}})
+	graph.RemoveNode(2)
+
+	expected := &DirectedGraph{
+		Nodes: map[Node]bool{1: true, 3: true},
+		Edges: map[Node][]Node{1: {}, 3: {1}},
+	}
+	if !graph.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, &graph)
+	}
+}
+
+func TestDirectedGraph_ToUndirected(t *testing.T) {
+	graph := DirectedGraph{}
+	graph.AddEdgesFromIntTupleList([][2]int{{1, 2}, {2, 1}, {2, 3}})
+
+	undirected := graph.ToUndirected()
+	if undirected.NumberOfEdges() != 2 {
+		t.Errorf("Expected reciprocal edges to collapse into 2 edges, but got %d", undirected.NumberOfEdges())
+	}
+}
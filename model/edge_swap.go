@@ -0,0 +1,261 @@
+package model
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// isSimpleGraph reports whether g has neither self-loops nor parallel edges.
+func isSimpleGraph(g *UndirectedGraph) bool {
+	for node, neighbours := range g.Edges {
+		seen := make(map[Node]bool, len(neighbours))
+		for _, neighbor := range neighbours {
+			if neighbor == node || seen[neighbor] {
+				return false
+			}
+			seen[neighbor] = true
+		}
+	}
+	return true
+}
+
+// edgeEnds lists every node once per incident edge end, so that a uniform pick from it followed by a uniform
+// pick among the neighbours of the chosen node is a uniformly random edge. Swaps keep the degrees, so the list
+// stays valid while edges are swapped.
+func edgeEnds(g *UndirectedGraph) []Node {
+	var ends []Node
+	for _, node := range sortedNodes(g.Nodes) {
+		for range g.Edges[node] {
+			ends = append(ends, node)
+		}
+	}
+	return ends
+}
+
+// checkSwapParameters returns an error if nswap swaps can't be attempted on a graph with the given size.
+func checkSwapParameters(nswap int, maxTries int, nodes int, edges int, minimumEdges int) error {
+	if nswap < 0 {
+		return fmt.Errorf("number of swaps can't be < 0")
+	}
+	if nswap > maxTries {
+		return fmt.Errorf("number of swaps can't be greater than the number of tries")
+	}
+	if nodes < 4 {
+		return fmt.Errorf("graph has fewer than four nodes")
+	}
+	if edges < minimumEdges {
+		return fmt.Errorf("graph has fewer than %d edges", minimumEdges)
+	}
+	return nil
+}
+
+// tryDoubleEdgeSwap picks two random edges u - v and x - y and replaces them by u - x and v - y, unless this
+// would create a self-loop or a parallel edge. It returns the four nodes and whether the swap was done.
+func tryDoubleEdgeSwap(g *UndirectedGraph, ends []Node, random *rand.Rand) ([4]Node, bool) {
+	u, x := ends[random.Intn(len(ends))], ends[random.Intn(len(ends))]
+	if u == x {
+		return [4]Node{}, false
+	}
+	v := g.Edges[u][random.Intn(len(g.Edges[u]))]
+	y := g.Edges[x][random.Intn(len(g.Edges[x]))]
+	if v == y || g.HasEdge(Edge{Node1: u, Node2: x}) || g.HasEdge(Edge{Node1: v, Node2: y}) {
+		return [4]Node{}, false
+	}
+	g.RemoveEdge(Edge{Node1: u, Node2: v})
+	g.RemoveEdge(Edge{Node1: x, Node2: y})
+	g.AddEdge(Edge{Node1: u, Node2: x})
+	g.AddEdge(Edge{Node1: v, Node2: y})
+	return [4]Node{u, v, x, y}, true
+}
+
+// undoDoubleEdgeSwap restores the edges u - v and x - y replaced by tryDoubleEdgeSwap.
+func undoDoubleEdgeSwap(g *UndirectedGraph, swap [4]Node) {
+	u, v, x, y := swap[0], swap[1], swap[2], swap[3]
+	g.RemoveEdge(Edge{Node1: u, Node2: x})
+	g.RemoveEdge(Edge{Node1: v, Node2: y})
+	g.AddEdge(Edge{Node1: u, Node2: v})
+	g.AddEdge(Edge{Node1: x, Node2: y})
+}
+
+/*
+DoubleEdgeSwap randomises a graph in place while keeping the degree of every node.
+
+Parameters:
+- g: A simple undirected graph with at least four nodes and two edges.
+- nswap: The number of swaps to perform.
+- maxTries: The maximum number of attempted swaps; attempts that would create a self-loop or a parallel edge are rejected.
+- options: Optional source of randomness, e.g. WithSeed(42) for a reproducible result.
+
+Returns:
+- graph: g itself, after nswap swaps, or after the swaps done so far if maxTries attempts were not enough; nil for any other error.
+- error: If g isn't simple, is too small, nswap > maxTries, or maxTries attempts were not enough.
+
+Description:
+A double edge swap removes two random edges u - v and x - y and adds u - x and
+v - y. Unlike the rewiring of WattsStrogatzRandomGraph, it keeps the degree
+sequence, so graphs randomised by enough swaps are the usual null model for
+testing whether a property is explained by the degrees alone. The graph may
+become disconnected; see ConnectedDoubleEdgeSwap.
+
+Example:
+
+	g, _ := DoubleEdgeSwap(PetersenGraph(), 100, 1000, WithSeed(42))
+	fmt.Println(g.NodeDegree(0)) // Output: 3
+*/
+func DoubleEdgeSwap(g *UndirectedGraph, nswap int, maxTries int, options ...RandomOption) (*UndirectedGraph, error) {
+	if err := checkSwapParameters(nswap, maxTries, len(g.Nodes), g.NumberOfEdges(), 2); err != nil {
+		return nil, err
+	}
+	if !isSimpleGraph(g) {
+		return nil, fmt.Errorf("double edge swaps need a graph without self-loops and parallel edges")
+	}
+
+	random := newRandom(options...)
+	ends := edgeEnds(g)
+	for swaps, tries := 0, 0; swaps < nswap; tries++ {
+		if tries >= maxTries {
+			return g, fmt.Errorf("maximum number of tries %d exceeded after %d swaps", maxTries, swaps)
+		}
+		if _, ok := tryDoubleEdgeSwap(g, ends, random); ok {
+			swaps++
+		}
+	}
+	return g, nil
+}
+
+/*
+ConnectedDoubleEdgeSwap performs double edge swaps in place while keeping the graph connected.
+
+Parameters:
+- g: A connected simple undirected graph with at least four nodes and two edges.
+- nswap: The number of swaps to perform.
+- maxTries: The maximum number of attempted swaps, including those undone because they disconnected the graph.
+- options: Optional source of randomness, e.g. WithSeed(42) for a reproducible result.
+
+Returns:
+- swaps: The number of swaps performed, nswap unless an error is returned.
+- error: If g isn't connected or simple, is too small, nswap > maxTries, or maxTries attempts were not enough.
+
+Description:
+Swaps are done in windows, and the connectivity is only checked at the end of
+every window (Gkantsidis, Mihail and Zegura). If the graph is still connected,
+the window grows by one, otherwise the swaps of the window are undone and the
+window is halved. Every swap keeps the degree of every node.
+*/
+func ConnectedDoubleEdgeSwap(g *UndirectedGraph, nswap int, maxTries int, options ...RandomOption) (int, error) {
+	if err := checkSwapParameters(nswap, maxTries, len(g.Nodes), g.NumberOfEdges(), 2); err != nil {
+		return 0, err
+	}
+	if !isSimpleGraph(g) {
+		return 0, fmt.Errorf("double edge swaps need a graph without self-loops and parallel edges")
+	}
+	if len(ConnectedComponents(g).ComponentsArray) != 1 {
+		return 0, fmt.Errorf("graph is not connected")
+	}
+
+	random := newRandom(options...)
+	ends := edgeEnds(g)
+	swaps, tries, window := 0, 0, 1
+	for swaps < nswap {
+		if tries >= maxTries {
+			return swaps, fmt.Errorf("maximum number of tries %d exceeded after %d swaps", maxTries, swaps)
+		}
+		var swapped [][4]Node
+		for len(swapped) < window && swaps+len(swapped) < nswap && tries < maxTries {
+			tries++
+			if swap, ok := tryDoubleEdgeSwap(g, ends, random); ok {
+				swapped = append(swapped, swap)
+			}
+		}
+		if len(ConnectedComponents(g).ComponentsArray) == 1 {
+			swaps += len(swapped)
+			window++
+			continue
+		}
+		for i := len(swapped) - 1; i >= 0; i-- {
+			undoDoubleEdgeSwap(g, swapped[i])
+		}
+		window = (window + 1) / 2
+	}
+	return swaps, nil
+}
+
+/*
+DirectedEdgeSwap randomises a directed graph in place while keeping the in- and out-degree of every node.
+
+Parameters:
+- g: A directed graph without parallel edges, with at least four nodes and three edges.
+- nswap: The number of swaps to perform.
+- maxTries: The maximum number of attempted swaps.
+- options: Optional source of randomness, e.g. WithSeed(42) for a reproducible result.
+
+Returns:
+- graph: g itself, after nswap swaps, or after the swaps done so far if maxTries attempts were not enough; nil for any other error.
+- error: If g has parallel edges, is too small, nswap > maxTries, or maxTries attempts were not enough.
+
+Description:
+Swapping the heads of two edges can't reach every graph with the given
+degrees, so every swap follows a random path a -> b -> c -> d of three edges
+and reorders it into a -> c -> b -> d (Erdős et al., triple edge swap). The
+swap is rejected if a new edge already exists or would be a self-loop.
+*/
+func DirectedEdgeSwap(g *DirectedGraph, nswap int, maxTries int, options ...RandomOption) (*DirectedGraph, error) {
+	if err := checkSwapParameters(nswap, maxTries, len(g.Nodes), g.NumberOfEdges(), 3); err != nil {
+		return nil, err
+	}
+
+	for node, successors := range g.Edges {
+		seen := make(map[Node]bool, len(successors))
+		for _, successor := range successors {
+			if seen[successor] {
+				return nil, fmt.Errorf("directed edge swaps need a graph without parallel edges, got %d -> %d twice", node, successor)
+			}
+			seen[successor] = true
+		}
+	}
+
+	random := newRandom(options...)
+	// Every node appears once per outgoing edge, so a uniform pick starts the path at a uniformly random edge
+	var tails []Node
+	for _, node := range sortedNodes(g.Nodes) {
+		for range g.Edges[node] {
+			tails = append(tails, node)
+		}
+	}
+	successor := func(node Node) (Node, bool) {
+		if len(g.Edges[node]) == 0 {
+			return node, false
+		}
+		return g.Edges[node][random.Intn(len(g.Edges[node]))], true
+	}
+
+	for swaps, tries := 0, 0; swaps < nswap; tries++ {
+		if tries >= maxTries {
+			return g, fmt.Errorf("maximum number of tries %d exceeded after %d swaps", maxTries, swaps)
+		}
+		a := tails[random.Intn(len(tails))]
+		b, _ := successor(a)
+		if a == b {
+			continue
+		}
+		c, ok := successor(b)
+		if !ok || b == c || a == c {
+			continue
+		}
+		d, ok := successor(c)
+		if !ok || c == d || b == d {
+			continue
+		}
+		if g.HasEdge(Edge{Node1: a, Node2: c}) || g.HasEdge(Edge{Node1: c, Node2: b}) || g.HasEdge(Edge{Node1: b, Node2: d}) {
+			continue
+		}
+		g.RemoveEdge(Edge{Node1: a, Node2: b})
+		g.RemoveEdge(Edge{Node1: b, Node2: c})
+		g.RemoveEdge(Edge{Node1: c, Node2: d})
+		g.AddEdge(Edge{Node1: a, Node2: c})
+		g.AddEdge(Edge{Node1: c, Node2: b})
+		g.AddEdge(Edge{Node1: b, Node2: d})
+		swaps++
+	}
+	return g, nil
+}
@@ -0,0 +1,167 @@
+package model
+
+import (
+	"fmt"
+	"maps"
+	"testing"
+)
+
+func TestDoubleEdgeSwap(t *testing.T) {
+	karate, _ := KarateClubGraph()
+	regular, _ := RandomRegularGraph(3, 30, WithSeed(1))
+	tests := map[string]*UndirectedGraph{
+		"KarateClub": karate,
+		"Regular":    regular,
+		"Petersen":   PetersenGraph(),
+	}
+	for name, g := range tests {
+		t.Run(name, func(t *testing.T) {
+			degrees := degreesOf(g, len(g.Nodes))
+			edges := edgeSet(g)
+			swapped, err := DoubleEdgeSwap(g, 50, 5000, WithSeed(2))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if swapped != g {
+				t.Errorf("Expected the graph to be swapped in place")
+			}
+			if fmt.Sprint(degreesOf(g, len(g.Nodes))) != fmt.Sprint(degrees) || !isSimpleGraph(g) {
+				t.Errorf("Expected a simple graph with degrees %v, but got %v", degrees, degreesOf(g, len(g.Nodes)))
+			}
+			if maps.Equal(edges, edgeSet(g)) {
+				t.Errorf("Expected the edges to change")
+			}
+		})
+	}
+}
+
+func TestDoubleEdgeSwapIsUniform(t *testing.T) {
+	// The perfect matchings 0 - 1, 2 - 3 and its two swaps are the three graphs with degrees 1, 1, 1, 1; each
+	// swap moves to one of the two others, so after several swaps each matching has probability close to 1/3
+	samples := 3000
+	counts := make(map[string]int)
+	for i := 0; i < samples; i++ {
+		g := &UndirectedGraph{}
+		g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {2, 3}})
+		if _, err := DoubleEdgeSwap(g, 7, 1000, WithSeed(int64(i))); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		counts[edgeKey(g)]++
+	}
+	// With 2 degrees of freedom, the chi-square statistic exceeds 13.8 with probability 0.001
+	if statistic := chiSquare(counts, 3, samples); len(counts) != 3 || statistic > 13.8 {
+		t.Errorf("Expected the three matchings equally often, but got %v", counts)
+	}
+}
+
+func TestDoubleEdgeSwapErrors(t *testing.T) {
+	if _, err := DoubleEdgeSwap(CycleGraph(6), 10, 5); err == nil {
+		t.Errorf("Expected an error for nswap > maxTries")
+	}
+	if _, err := DoubleEdgeSwap(PathGraph(3), 1, 10); err == nil {
+		t.Errorf("Expected an error for a graph with fewer than four nodes")
+	}
+	multigraph := CycleGraph(5)
+	multigraph.AddEdge(Edge{Node1: 0, Node2: 1})
+	if _, err := DoubleEdgeSwap(multigraph, 1, 10); err == nil {
+		t.Errorf("Expected an error for a multigraph")
+	}
+	// Every swap in a complete graph would create a parallel edge
+	complete := CompleteGraph(5)
+	if swapped, err := DoubleEdgeSwap(complete, 1, 100, WithSeed(1)); err == nil || swapped != complete {
+		t.Errorf("Expected an error and the unchanged graph when no swap is possible, but got %v (%v)", swapped, err)
+	}
+}
+
+func TestConnectedDoubleEdgeSwap(t *testing.T) {
+	// A long cycle with few chords disconnects under most swaps
+	g := CycleGraph(40)
+	g.AddEdgesFromIntTupleList([][2]int{{0, 20}, {10, 30}})
+	degrees := degreesOf(g, 40)
+	edges := edgeSet(g)
+	swaps, err := ConnectedDoubleEdgeSwap(g, 30, 10000, WithSeed(3))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if swaps != 30 {
+		t.Errorf("Expected 30 swaps, but got %d", swaps)
+	}
+	if len(ConnectedComponents(g).ComponentsArray) != 1 {
+		t.Errorf("Expected the graph to stay connected")
+	}
+	if fmt.Sprint(degreesOf(g, 40)) != fmt.Sprint(degrees) || !isSimpleGraph(g) || maps.Equal(edges, edgeSet(g)) {
+		t.Errorf("Expected a different simple graph with degrees %v, but got %v", degrees, g)
+	}
+
+	if _, err := ConnectedDoubleEdgeSwap(twoTriangles(), 1, 10); err == nil {
+		t.Errorf("Expected an error for a disconnected graph")
+	}
+	// Every swap of a star would create a self-loop or a parallel edge
+	if swaps, err := ConnectedDoubleEdgeSwap(StarGraph(6), 1, 50, WithSeed(1)); err == nil || swaps != 0 {
+		t.Errorf("Expected an error and no swaps, but got %d (%v)", swaps, err)
+	}
+}
+
+func TestDirectedEdgeSwap(t *testing.T) {
+	g, _ := DirectedGNPRandomGraph(30, 0.15, WithSeed(4))
+	inDegrees, outDegrees := make(map[Node]int), make(map[Node]int)
+	for node := range g.Nodes {
+		inDegrees[node], outDegrees[node] = g.InDegree(node), g.OutDegree(node)
+	}
+	edges := fmt.Sprint(sortedDirectedEdges(g))
+	swapped, err := DirectedEdgeSwap(g, 40, 4000, WithSeed(5))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if swapped != g {
+		t.Errorf("Expected the graph to be swapped in place")
+	}
+	for node := range g.Nodes {
+		if g.InDegree(node) != inDegrees[node] || g.OutDegree(node) != outDegrees[node] {
+			t.Errorf("Expected node %d to keep degrees %d and %d, but got %d and %d", node, inDegrees[node], outDegrees[node], g.InDegree(node), g.OutDegree(node))
+		}
+		if g.HasEdge(Edge{Node1: node, Node2: node}) {
+			t.Errorf("Unexpected self-loop at node %d", node)
+		}
+	}
+	if fmt.Sprint(sortedDirectedEdges(g)) == edges {
+		t.Errorf("Expected the edges to change")
+	}
+
+	// Reordering 0 -> 1 -> 2 -> 3 of a directed 5-cycle into 0 -> 2 -> 1 -> 3 creates no existing edge
+	cycle := &DirectedGraph{}
+	cycle.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 0}})
+	if _, err := DirectedEdgeSwap(cycle, 1, 100, WithSeed(1)); err != nil {
+		t.Errorf("Unexpected error for a directed 5-cycle: %v", err)
+	}
+
+	parallel := &DirectedGraph{}
+	parallel.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 1}, {1, 2}, {2, 3}})
+	if _, err := DirectedEdgeSwap(parallel, 1, 10); err == nil {
+		t.Errorf("Expected an error for parallel edges")
+	}
+	small := &DirectedGraph{}
+	small.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {2, 3}})
+	if _, err := DirectedEdgeSwap(small, 5, 2); err == nil {
+		t.Errorf("Expected an error for nswap > maxTries")
+	}
+}
+
+// sortedDirectedEdges returns the edges of a directed graph in increasing order.
+func sortedDirectedEdges(g *DirectedGraph) []Edge {
+	edges := g.GetEdgeTuples()
+	sortEdges(edges)
+	return edges
+}
+
+func TestEdgeSwapsAreReproducible(t *testing.T) {
+	g1, g2 := PetersenGraph(), PetersenGraph()
+	DoubleEdgeSwap(g1, 20, 1000, WithSeed(6))
+	DoubleEdgeSwap(g2, 20, 1000, WithSeed(6))
+	c1, c2 := PetersenGraph(), PetersenGraph()
+	ConnectedDoubleEdgeSwap(c1, 20, 1000, WithSeed(6))
+	ConnectedDoubleEdgeSwap(c2, 20, 1000, WithSeed(6))
+	if !g1.Equals(g2) || !c1.Equals(c2) {
+		t.Errorf("Expected the same graphs for the same seed")
+	}
+}
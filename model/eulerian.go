@@ -0,0 +1,265 @@
+package model
+
+import (
+	"fmt"
+)
+
+// oddDegreeNodes returns the nodes of odd degree in increasing order. A self-loop adds two to the degree.
+func oddDegreeNodes(g *UndirectedGraph) []Node {
+	var odd []Node
+	for _, node := range sortedNodes(g.Nodes) {
+		if g.NodeDegree(node)%2 == 1 {
+			odd = append(odd, node)
+		}
+	}
+	return odd
+}
+
+// edgesConnected reports whether all nodes with at least one incident edge belong to the same connected component.
+func edgesConnected(g *UndirectedGraph) bool {
+	var withEdges []Node
+	for _, node := range sortedNodes(g.Nodes) {
+		if len(g.Edges[node]) > 0 {
+			withEdges = append(withEdges, node)
+		}
+	}
+	if len(withEdges) == 0 {
+		return true
+	}
+	visited := map[Node]bool{withEdges[0]: true}
+	queue := []Node{withEdges[0]}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, neighbor := range g.Edges[node] {
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+	return len(visited) == len(withEdges)
+}
+
+/*
+IsEulerian reports whether the graph has an Eulerian circuit, i.e. a closed walk using every edge exactly once.
+
+Description:
+A graph is Eulerian when it is connected and every node has even degree.
+Parallel edges are used once each and a self-loop adds two to the degree of
+its node. The null graph is not Eulerian.
+
+Example:
+
+	fmt.Println(IsEulerian(CycleGraph(5)))    // Output: true
+	fmt.Println(IsEulerian(CompleteGraph(4))) // Output: false
+*/
+func IsEulerian(g *UndirectedGraph) bool {
+	if len(g.Nodes) == 0 || len(oddDegreeNodes(g)) > 0 {
+		return false
+	}
+	return len(ConnectedComponents(g).ComponentsArray) == 1
+}
+
+// HasEulerianPath reports whether the graph has a walk using every edge exactly once.
+// This holds when the nodes with incident edges are connected and zero or two nodes have odd degree;
+// isolated nodes are ignored.
+func HasEulerianPath(g *UndirectedGraph) bool {
+	odd := len(oddDegreeNodes(g))
+	return (odd == 0 || odd == 2) && edgesConnected(g)
+}
+
+// eulerianIncidence lists, for every node, its incident edges as (neighbour, edge id) pairs,
+// giving each parallel edge and self-loop its own id.
+func eulerianIncidence(g *UndirectedGraph) (map[Node][][2]int, int) {
+	incidence := make(map[Node][][2]int, len(g.Nodes))
+	numberOfEdges := 0
+	for _, node := range sortedNodes(g.Nodes) {
+		selfLoops := 0
+		for _, neighbor := range g.Edges[node] {
+			switch {
+			case neighbor == node:
+				// A self-loop appears twice in the adjacency list of its node
+				selfLoops++
+				if selfLoops%2 == 0 {
+					incidence[node] = append(incidence[node], [2]int{int(node), numberOfEdges})
+					numberOfEdges++
+				}
+			case node < neighbor:
+				incidence[node] = append(incidence[node], [2]int{int(neighbor), numberOfEdges})
+				incidence[neighbor] = append(incidence[neighbor], [2]int{int(node), numberOfEdges})
+				numberOfEdges++
+			}
+		}
+	}
+	return incidence, numberOfEdges
+}
+
+// hierholzer returns an Eulerian walk starting from source, assuming one exists.
+func hierholzer(g *UndirectedGraph, source Node) []Edge {
+	incidence, numberOfEdges := eulerianIncidence(g)
+	used := make([]bool, numberOfEdges)
+	next := make(map[Node]int, len(incidence))
+
+	var walk []Node
+	stack := []Node{source}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		advanced := false
+		for next[node] < len(incidence[node]) {
+			entry := incidence[node][next[node]]
+			next[node]++
+			if !used[entry[1]] {
+				used[entry[1]] = true
+				stack = append(stack, Node(entry[0]))
+				advanced = true
+				break
+			}
+		}
+		if !advanced {
+			walk = append(walk, node)
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	edges := make([]Edge, 0, len(walk)-1)
+	for i := len(walk) - 1; i > 0; i-- {
+		edges = append(edges, Edge{Node1: walk[i], Node2: walk[i-1]})
+	}
+	return edges
+}
+
+/*
+EulerianCircuit returns an Eulerian circuit of the graph.
+
+Parameters:
+- g: The undirected graph.
+- source: The node the circuit starts and ends at.
+
+Returns:
+- circuit: The edges in traversal order; Node2 of every edge is Node1 of the next one.
+- error: If the graph is not Eulerian or source is not in the graph.
+
+Description:
+The function implements Hierholzer's algorithm and runs in O(V+E).
+
+Example:
+
+	circuit, _ := EulerianCircuit(CycleGraph(3), 0)
+	fmt.Println(circuit) // Output: [{0 1} {1 2} {2 0}]
+*/
+func EulerianCircuit(g *UndirectedGraph, source Node) ([]Edge, error) {
+	if !g.HasNode(source) {
+		return nil, fmt.Errorf("node %d is not in the graph", source)
+	}
+	if !IsEulerian(g) {
+		return nil, fmt.Errorf("graph is not Eulerian")
+	}
+	return hierholzer(g, source), nil
+}
+
+/*
+EulerianPath returns an Eulerian path of the graph.
+
+Parameters:
+- g: The undirected graph.
+
+Returns:
+- path: The edges in traversal order. When two nodes have odd degree the path goes from the smaller to the larger one, otherwise it is a circuit starting at the smallest node with an incident edge.
+- error: If the graph has no Eulerian path.
+*/
+func EulerianPath(g *UndirectedGraph) ([]Edge, error) {
+	if !HasEulerianPath(g) {
+		return nil, fmt.Errorf("graph has no Eulerian path")
+	}
+	odd := oddDegreeNodes(g)
+	if len(odd) == 2 {
+		return hierholzer(g, odd[0]), nil
+	}
+	for _, node := range sortedNodes(g.Nodes) {
+		if len(g.Edges[node]) > 0 {
+			return hierholzer(g, node), nil
+		}
+	}
+	return nil, nil
+}
+
+/*
+Eulerize returns an Eulerian multigraph obtained by duplicating edges of the graph.
+
+Parameters:
+- g: The connected undirected graph.
+
+Returns:
+- eulerian: A copy of g in which some existing edges appear more than once, so that every node has even degree. The number of duplicated edges is minimum.
+- error: If the graph is empty or not connected.
+
+Description:
+Odd-degree nodes are paired by a minimum weight perfect matching on their
+shortest path distances, and the edges of the shortest path between each pair
+are duplicated, as in the solution of the Chinese postman problem.
+*/
+func Eulerize(g *UndirectedGraph) (*UndirectedGraph, error) {
+	if len(g.Nodes) == 0 {
+		return nil, fmt.Errorf("cannot eulerize the null graph")
+	}
+	if len(ConnectedComponents(g).ComponentsArray) != 1 {
+		return nil, fmt.Errorf("cannot eulerize a disconnected graph")
+	}
+
+	eulerian := &UndirectedGraph{
+		Nodes: make(map[Node]bool, len(g.Nodes)),
+		Edges: make(map[Node][]Node, len(g.Edges)),
+	}
+	for node := range g.Nodes {
+		eulerian.AddNode(node)
+		if len(g.Edges[node]) > 0 {
+			eulerian.Edges[node] = append([]Node(nil), g.Edges[node]...)
+		}
+	}
+
+	odd := oddDegreeNodes(g)
+	if len(odd) == 0 {
+		return eulerian, nil
+	}
+
+	// Breadth-first search trees from every odd node give the shortest paths
+	parents := make(map[Node]map[Node]Node, len(odd))
+	distances := make(map[Node]map[Node]int, len(odd))
+	longest := 0
+	for _, source := range odd {
+		parents[source] = map[Node]Node{source: source}
+		distances[source] = map[Node]int{source: 0}
+		queue := []Node{source}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			for _, neighbor := range g.Edges[node] {
+				if _, seen := distances[source][neighbor]; !seen {
+					distances[source][neighbor] = distances[source][node] + 1
+					parents[source][neighbor] = node
+					longest = max(longest, distances[source][neighbor])
+					queue = append(queue, neighbor)
+				}
+			}
+		}
+	}
+
+	pairs := &UndirectedGraph{}
+	weights := make(map[Edge]float64)
+	for i, u := range odd {
+		for _, v := range odd[i+1:] {
+			edge := Edge{Node1: u, Node2: v}
+			pairs.AddEdge(edge)
+			weights[edge] = float64(longest + 1 - distances[u][v])
+		}
+	}
+	for _, edge := range MaxWeightMatching(pairs, weights, true) {
+		for node := edge.Node2; node != edge.Node1; {
+			parent := parents[edge.Node1][node]
+			eulerian.AddEdge(Edge{Node1: parent, Node2: node})
+			node = parent
+		}
+	}
+	return eulerian, nil
+}
@@ -0,0 +1,154 @@
+package model
+
+import (
+	"testing"
+)
+
+// checkEulerianWalk verifies that walk is a connected walk using every edge of g exactly once.
+func checkEulerianWalk(t *testing.T, g *UndirectedGraph, walk []Edge) {
+	t.Helper()
+	if len(walk) != g.NumberOfEdges() {
+		t.Fatalf("Expected %d edges, but got %v", g.NumberOfEdges(), walk)
+	}
+	remaining := make(map[Edge]int)
+	for _, edge := range g.GetEdgeTuples() {
+		remaining[edge]++
+	}
+	for i, edge := range walk {
+		if i > 0 && walk[i-1].Node2 != edge.Node1 {
+			t.Fatalf("Walk %v is broken at position %d", walk, i)
+		}
+		reversed := Edge{Node1: edge.Node2, Node2: edge.Node1}
+		if remaining[edge] == 0 || remaining[reversed] == 0 {
+			t.Fatalf("Edge %v is used too often in %v", edge, walk)
+		}
+		remaining[edge]--
+		if edge != reversed {
+			remaining[reversed]--
+		} else {
+			// A self-loop appears twice in the adjacency list of its node
+			remaining[edge]--
+		}
+	}
+}
+
+func TestIsEulerian(t *testing.T) {
+	testCases := []struct {
+		name     string
+		graph    *UndirectedGraph
+		eulerian bool
+		hasPath  bool
+	}{
+		{name: "Cycle graph", graph: CycleGraph(6), eulerian: true, hasPath: true},
+		{name: "Complete graph K5", graph: CompleteGraph(5), eulerian: true, hasPath: true},
+		{name: "Complete graph K4", graph: CompleteGraph(4), eulerian: false, hasPath: false},
+		{name: "Path graph", graph: PathGraph(5), eulerian: false, hasPath: true},
+		{name: "Circulant graph", graph: CirculantGraph(7, 3), eulerian: true, hasPath: true},
+		{name: "Star graph", graph: StarGraph(4), eulerian: false, hasPath: false},
+		{name: "Null graph", graph: NullGraph(), eulerian: false, hasPath: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if IsEulerian(tc.graph) != tc.eulerian {
+				t.Errorf("Expected IsEulerian to be %v", tc.eulerian)
+			}
+			if HasEulerianPath(tc.graph) != tc.hasPath {
+				t.Errorf("Expected HasEulerianPath to be %v", tc.hasPath)
+			}
+		})
+	}
+}
+
+func TestEulerianCircuit(t *testing.T) {
+	withLoop := CycleGraph(4)
+	withLoop.AddEdge(Edge{Node1: 2, Node2: 2})
+	withLoop.AddEdge(Edge{Node1: 0, Node2: 1})
+	withLoop.AddEdge(Edge{Node1: 0, Node2: 1})
+
+	testCases := []struct {
+		name  string
+		graph *UndirectedGraph
+	}{
+		{name: "Cycle graph", graph: CycleGraph(6)},
+		{name: "Complete graph", graph: CompleteGraph(7)},
+		{name: "Circulant graph", graph: CirculantGraph(9, 2)},
+		{name: "Self-loop and parallel edges", graph: withLoop},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			circuit, err := EulerianCircuit(tc.graph, 1)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			checkEulerianWalk(t, tc.graph, circuit)
+			if circuit[0].Node1 != 1 || circuit[len(circuit)-1].Node2 != 1 {
+				t.Errorf("Circuit %v does not start and end at 1", circuit)
+			}
+		})
+	}
+
+	if _, err := EulerianCircuit(PathGraph(3), 0); err == nil {
+		t.Errorf("Expected an error for a non-Eulerian graph")
+	}
+	if _, err := EulerianCircuit(CycleGraph(3), 7); err == nil {
+		t.Errorf("Expected an error for a missing source")
+	}
+}
+
+func TestEulerianPath(t *testing.T) {
+	lollipop := LollipopGraph(5, 3)
+	path, err := EulerianPath(lollipop)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	checkEulerianWalk(t, lollipop, path)
+	if path[0].Node1 != 4 || path[len(path)-1].Node2 != 7 {
+		t.Errorf("Expected a path from 4 to 7, but got %v", path)
+	}
+
+	if _, err := EulerianPath(StarGraph(5)); err == nil {
+		t.Errorf("Expected an error for a graph with four odd nodes")
+	}
+}
+
+func TestEulerize(t *testing.T) {
+	testCases := []struct {
+		name  string
+		graph *UndirectedGraph
+		added int
+	}{
+		{name: "Cycle graph", graph: CycleGraph(5), added: 0},
+		{name: "Complete graph", graph: CompleteGraph(4), added: 2},
+		{name: "Path graph", graph: PathGraph(5), added: 4},
+		{name: "Star graph", graph: StarGraph(5), added: 4},
+		{name: "Ladder graph", graph: LadderGraph(4), added: 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			eulerian, err := Eulerize(tc.graph)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !IsEulerian(eulerian) {
+				t.Errorf("Result is not Eulerian: %v", eulerian)
+			}
+			if added := eulerian.NumberOfEdges() - tc.graph.NumberOfEdges(); added != tc.added {
+				t.Errorf("Expected %d duplicated edges, but got %d", tc.added, added)
+			}
+			for _, edge := range eulerian.GetEdgeTuples() {
+				if !tc.graph.HasEdge(edge) {
+					t.Errorf("Edge %v is not in the original graph", edge)
+				}
+			}
+		})
+	}
+
+	disconnected := CycleGraph(3)
+	disconnected.AddNode(9)
+	if _, err := Eulerize(disconnected); err == nil {
+		t.Errorf("Expected an error for a disconnected graph")
+	}
+}
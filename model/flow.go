@@ -0,0 +1,661 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+// flowEpsilon is the tolerance used when comparing residual capacities.
+const flowEpsilon = 1e-12
+
+// Flow is the result of a maximum flow computation.
+type Flow struct {
+	// Value is the total amount of flow sent from the source to the sink.
+	Value float64
+	// EdgeFlows holds the flow routed along every edge with positive flow.
+	EdgeFlows map[Edge]float64
+}
+
+// Cut is a partition of the nodes of a graph into two sets.
+type Cut struct {
+	// Value is the total capacity (or weight) of the edges crossing the cut.
+	Value float64
+	// SourceSide and SinkSide are the two sides of the partition.
+	SourceSide map[Node]bool
+	SinkSide   map[Node]bool
+	// CutEdges lists the edges going from SourceSide to SinkSide.
+	CutEdges []Edge
+}
+
+// MaxFlowAlgorithm is the common signature of the maximum flow solvers.
+type MaxFlowAlgorithm func(g *DirectedGraph, capacity map[Edge]float64, source Node, sink Node) (Flow, error)
+
+type residualArc struct {
+	to       int
+	capacity float64
+	residual float64
+	reverse  int
+}
+
+// residualNetwork is the indexed representation shared by the flow algorithms.
+// Every edge of the input graph becomes a forward arc paired with a reverse arc
+// of zero capacity.
+type residualNetwork struct {
+	nodes []Node
+	index map[Node]int
+	arcs  [][]residualArc
+}
+
+func newResidualNetwork(g *DirectedGraph, capacity map[Edge]float64) (*residualNetwork, error) {
+	network := &residualNetwork{
+		nodes: sortedNodes(g.Nodes),
+		index: make(map[Node]int, len(g.Nodes)),
+	}
+	for idx, node := range network.nodes {
+		network.index[node] = idx
+	}
+	network.arcs = make([][]residualArc, len(network.nodes))
+
+	for _, from := range network.nodes {
+		for _, to := range g.Edges[from] {
+			value := edgeCapacity(capacity, Edge{Node1: from, Node2: to})
+			if value < 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+				return nil, fmt.Errorf("capacity of edge %v must be finite and non-negative", Edge{Node1: from, Node2: to})
+			}
+			u, v := network.index[from], network.index[to]
+			network.arcs[u] = append(network.arcs[u], residualArc{to: v, capacity: value, residual: value, reverse: len(network.arcs[v])})
+			network.arcs[v] = append(network.arcs[v], residualArc{to: u, capacity: 0, residual: 0, reverse: len(network.arcs[u]) - 1})
+		}
+	}
+	return network, nil
+}
+
+// edgeCapacity returns the capacity of an edge. Edges absent from the map have unit capacity.
+func edgeCapacity(capacity map[Edge]float64, edge Edge) float64 {
+	if value, ok := capacity[edge]; ok {
+		return value
+	}
+	return 1
+}
+
+func (network *residualNetwork) push(u int, arcIdx int, amount float64) {
+	arc := &network.arcs[u][arcIdx]
+	arc.residual -= amount
+	network.arcs[arc.to][arc.reverse].residual += amount
+}
+
+// flow extracts the flow carried by the forward arcs of the network.
+func (network *residualNetwork) flow(source int) Flow {
+	result := Flow{EdgeFlows: make(map[Edge]float64)}
+	for u, arcs := range network.arcs {
+		for _, arc := range arcs {
+			if arc.capacity == 0 {
+				continue
+			}
+			if amount := arc.capacity - arc.residual; amount > flowEpsilon {
+				result.EdgeFlows[Edge{Node1: network.nodes[u], Node2: network.nodes[arc.to]}] += amount
+			}
+		}
+	}
+	for _, arc := range network.arcs[source] {
+		result.Value += arc.capacity - arc.residual
+	}
+	return result
+}
+
+// reachable returns the nodes reachable from source through arcs with positive residual capacity.
+func (network *residualNetwork) reachable(source int) []bool {
+	visited := make([]bool, len(network.nodes))
+	visited[source] = true
+	queue := []int{source}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, arc := range network.arcs[u] {
+			if !visited[arc.to] && arc.residual > flowEpsilon {
+				visited[arc.to] = true
+				queue = append(queue, arc.to)
+			}
+		}
+	}
+	return visited
+}
+
+func validateTerminals(g *DirectedGraph, source Node, sink Node) error {
+	if !g.HasNode(source) {
+		return fmt.Errorf("source node %d not in graph", source)
+	}
+	if !g.HasNode(sink) {
+		return fmt.Errorf("sink node %d not in graph", sink)
+	}
+	if source == sink {
+		return fmt.Errorf("source and sink must be different nodes")
+	}
+	return nil
+}
+
+/*
+EdmondsKarpMaxFlow computes a maximum flow from source to sink using the Edmonds-Karp algorithm.
+
+Parameters:
+- g: The directed graph the flow is routed on. Use UndirectedGraph.ToDirected for undirected networks.
+- capacity: Capacity of every edge. Edges absent from the map have unit capacity, so a nil map counts edge-disjoint paths.
+- source, sink: The terminals of the flow.
+
+Description:
+The algorithm repeatedly augments the flow along a shortest path (in number of
+edges) of the residual network, found with a breadth-first search. It runs in
+O(V E^2) time.
+
+Example:
+
+	g := DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {0, 2}})
+	flow, _ := EdmondsKarpMaxFlow(&g, map[Edge]float64{{0, 1}: 3, {1, 2}: 2, {0, 2}: 1}, 0, 2)
+	fmt.Println(flow.Value) // Output: 3
+*/
+func EdmondsKarpMaxFlow(g *DirectedGraph, capacity map[Edge]float64, source Node, sink Node) (Flow, error) {
+	network, err := edmondsKarp(g, capacity, source, sink)
+	if err != nil {
+		return Flow{}, err
+	}
+	return network.flow(network.index[source]), nil
+}
+
+func edmondsKarp(g *DirectedGraph, capacity map[Edge]float64, source Node, sink Node) (*residualNetwork, error) {
+	if err := validateTerminals(g, source, sink); err != nil {
+		return nil, err
+	}
+	network, err := newResidualNetwork(g, capacity)
+	if err != nil {
+		return nil, err
+	}
+	s, t := network.index[source], network.index[sink]
+
+	type parentArc struct {
+		node int
+		arc  int
+	}
+	for {
+		parents := make([]parentArc, len(network.nodes))
+		for i := range parents {
+			parents[i].node = -1
+		}
+		parents[s].node = s
+		queue := []int{s}
+		for len(queue) > 0 && parents[t].node < 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for arcIdx, arc := range network.arcs[u] {
+				if parents[arc.to].node < 0 && arc.residual > flowEpsilon {
+					parents[arc.to] = parentArc{node: u, arc: arcIdx}
+					queue = append(queue, arc.to)
+				}
+			}
+		}
+		if parents[t].node < 0 {
+			return network, nil
+		}
+
+		bottleneck := math.Inf(1)
+		for v := t; v != s; v = parents[v].node {
+			bottleneck = min(bottleneck, network.arcs[parents[v].node][parents[v].arc].residual)
+		}
+		for v := t; v != s; v = parents[v].node {
+			network.push(parents[v].node, parents[v].arc, bottleneck)
+		}
+	}
+}
+
+// DinicMaxFlow computes a maximum flow from source to sink using Dinic's
+// algorithm. Each phase builds a level graph with a breadth-first search and
+// saturates it with a blocking flow, giving O(V^2 E) time overall. Parameters
+// follow EdmondsKarpMaxFlow.
+func DinicMaxFlow(g *DirectedGraph, capacity map[Edge]float64, source Node, sink Node) (Flow, error) {
+	network, err := dinic(g, capacity, source, sink)
+	if err != nil {
+		return Flow{}, err
+	}
+	return network.flow(network.index[source]), nil
+}
+
+func dinic(g *DirectedGraph, capacity map[Edge]float64, source Node, sink Node) (*residualNetwork, error) {
+	if err := validateTerminals(g, source, sink); err != nil {
+		return nil, err
+	}
+	network, err := newResidualNetwork(g, capacity)
+	if err != nil {
+		return nil, err
+	}
+	s, t := network.index[source], network.index[sink]
+	level := make([]int, len(network.nodes))
+	next := make([]int, len(network.nodes))
+
+	var augment func(u int, limit float64) float64
+	augment = func(u int, limit float64) float64 {
+		if u == t {
+			return limit
+		}
+		for ; next[u] < len(network.arcs[u]); next[u]++ {
+			arc := network.arcs[u][next[u]]
+			if arc.residual <= flowEpsilon || level[arc.to] != level[u]+1 {
+				continue
+			}
+			if pushed := augment(arc.to, min(limit, arc.residual)); pushed > flowEpsilon {
+				network.push(u, next[u], pushed)
+				return pushed
+			}
+		}
+		return 0
+	}
+
+	for {
+		for i := range level {
+			level[i] = -1
+		}
+		level[s] = 0
+		queue := []int{s}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, arc := range network.arcs[u] {
+				if level[arc.to] < 0 && arc.residual > flowEpsilon {
+					level[arc.to] = level[u] + 1
+					queue = append(queue, arc.to)
+				}
+			}
+		}
+		if level[t] < 0 {
+			return network, nil
+		}
+
+		for i := range next {
+			next[i] = 0
+		}
+		for pushed := augment(s, math.Inf(1)); pushed > flowEpsilon; pushed = augment(s, math.Inf(1)) {
+			// keep augmenting until the level graph is blocked
+		}
+	}
+}
+
+// PushRelabelMaxFlow computes a maximum flow from source to sink using the
+// FIFO variant of the Goldberg-Tarjan push-relabel algorithm with the gap
+// heuristic. It runs in O(V^3) time and tends to outperform augmenting path
+// methods on dense networks. Parameters follow EdmondsKarpMaxFlow.
+func PushRelabelMaxFlow(g *DirectedGraph, capacity map[Edge]float64, source Node, sink Node) (Flow, error) {
+	network, err := pushRelabel(g, capacity, source, sink)
+	if err != nil {
+		return Flow{}, err
+	}
+	return network.flow(network.index[source]), nil
+}
+
+func pushRelabel(g *DirectedGraph, capacity map[Edge]float64, source Node, sink Node) (*residualNetwork, error) {
+	if err := validateTerminals(g, source, sink); err != nil {
+		return nil, err
+	}
+	network, err := newResidualNetwork(g, capacity)
+	if err != nil {
+		return nil, err
+	}
+	n := len(network.nodes)
+	s, t := network.index[source], network.index[sink]
+
+	height := make([]int, n)
+	excess := make([]float64, n)
+	current := make([]int, n)
+	heightCount := make([]int, 2*n+1)
+	active := make([]bool, n)
+	var queue []int
+
+	enqueue := func(u int) {
+		if !active[u] && u != s && u != t && excess[u] > flowEpsilon {
+			active[u] = true
+			queue = append(queue, u)
+		}
+	}
+
+	height[s] = n
+	heightCount[0] = n - 1
+	heightCount[n] = 1
+	for arcIdx, arc := range network.arcs[s] {
+		if arc.residual > 0 {
+			amount := arc.residual
+			network.push(s, arcIdx, amount)
+			excess[arc.to] += amount
+			excess[s] -= amount
+			enqueue(arc.to)
+		}
+	}
+
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		active[u] = false
+
+		for excess[u] > flowEpsilon {
+			if current[u] == len(network.arcs[u]) {
+				// Relabel: lift u just above its lowest residual neighbour
+				oldHeight := height[u]
+				newHeight := 2 * n
+				for _, arc := range network.arcs[u] {
+					if arc.residual > flowEpsilon {
+						newHeight = min(newHeight, height[arc.to]+1)
+					}
+				}
+				heightCount[oldHeight]--
+				height[u] = newHeight
+				heightCount[newHeight]++
+				current[u] = 0
+
+				// Gap heuristic: nodes above an empty level can no longer reach the sink
+				if heightCount[oldHeight] == 0 && oldHeight < n {
+					for v := range height {
+						if v != s && height[v] > oldHeight && height[v] < n {
+							heightCount[height[v]]--
+							height[v] = n + 1
+							heightCount[height[v]]++
+							current[v] = 0
+						}
+					}
+				}
+				if height[u] >= 2*n {
+					break
+				}
+				continue
+			}
+
+			arc := network.arcs[u][current[u]]
+			if arc.residual > flowEpsilon && height[u] == height[arc.to]+1 {
+				amount := min(excess[u], arc.residual)
+				network.push(u, current[u], amount)
+				excess[u] -= amount
+				excess[arc.to] += amount
+				enqueue(arc.to)
+			} else {
+				current[u]++
+			}
+		}
+	}
+	return network, nil
+}
+
+/*
+MinimumCut computes a minimum s-t cut, i.e. a set of edges of minimum total
+capacity whose removal disconnects sink from source.
+
+Parameters:
+- g, capacity, source, sink: As in EdmondsKarpMaxFlow.
+
+Returns:
+- cut: The cut whose SourceSide contains every node still reachable from source in the residual network of a maximum flow. By the max-flow min-cut theorem, cut.Value equals the maximum flow value.
+- error: If the terminals are invalid or a capacity is negative or not finite.
+*/
+func MinimumCut(g *DirectedGraph, capacity map[Edge]float64, source Node, sink Node) (Cut, error) {
+	network, err := dinic(g, capacity, source, sink)
+	if err != nil {
+		return Cut{}, err
+	}
+
+	reachable := network.reachable(network.index[source])
+	cut := Cut{
+		SourceSide: make(map[Node]bool),
+		SinkSide:   make(map[Node]bool),
+	}
+	for idx, node := range network.nodes {
+		if reachable[idx] {
+			cut.SourceSide[node] = true
+		} else {
+			cut.SinkSide[node] = true
+		}
+	}
+	for u, arcs := range network.arcs {
+		for _, arc := range arcs {
+			if arc.capacity > 0 && reachable[u] && !reachable[arc.to] {
+				cut.CutEdges = append(cut.CutEdges, Edge{Node1: network.nodes[u], Node2: network.nodes[arc.to]})
+				cut.Value += arc.capacity
+			}
+		}
+	}
+	return cut, nil
+}
+
+/*
+StoerWagnerMinimumCut computes a global minimum cut of a weighted undirected graph.
+
+Parameters:
+- g: The undirected graph. It must contain at least two nodes.
+- weights: Weight of every edge, looked up in either orientation. Edges absent from the map have unit weight.
+
+Description:
+The Stoer-Wagner algorithm runs n-1 phases. Each phase orders the nodes by
+maximum adjacency, records the cut separating the last node from the rest and
+merges the last two nodes. The lightest cut found is a global minimum cut. The
+implementation runs in O(V^3) time. A disconnected graph has a cut of value 0.
+
+Returns:
+- cut: The minimum cut. CutEdges lists every edge of g crossing the partition once.
+- error: If the graph has fewer than two nodes or a weight is negative.
+*/
+func StoerWagnerMinimumCut(g *UndirectedGraph, weights map[Edge]float64) (Cut, error) {
+	nodes := sortedNodes(g.Nodes)
+	n := len(nodes)
+	if n < 2 {
+		return Cut{}, fmt.Errorf("graph must have at least 2 nodes")
+	}
+	index := make(map[Node]int, n)
+	for idx, node := range nodes {
+		index[node] = idx
+	}
+
+	weight := make([][]float64, n)
+	for i := range weight {
+		weight[i] = make([]float64, n)
+	}
+	for _, edge := range g.GetEdgeTuples() {
+		if edge.Node1 == edge.Node2 {
+			continue
+		}
+		w := undirectedEdgeWeight(weights, edge)
+		if w < 0 {
+			return Cut{}, fmt.Errorf("weight of edge %v must be non-negative", edge)
+		}
+		// every undirected edge is listed once per orientation
+		weight[index[edge.Node1]][index[edge.Node2]] += w / 2
+		weight[index[edge.Node2]][index[edge.Node1]] += w / 2
+	}
+
+	groups := make([][]int, n)
+	for i := range groups {
+		groups[i] = []int{i}
+	}
+	merged := make([]bool, n)
+	bestValue := math.Inf(1)
+	var bestGroup []int
+
+	for phase := 0; phase < n-1; phase++ {
+		inA := make([]bool, n)
+		connectivity := make([]float64, n)
+		previous, last := -1, -1
+		for step := 0; step < n-phase; step++ {
+			selected := -1
+			for v := 0; v < n; v++ {
+				if merged[v] || inA[v] {
+					continue
+				}
+				if selected < 0 || connectivity[v] > connectivity[selected] {
+					selected = v
+				}
+			}
+			inA[selected] = true
+			previous, last = last, selected
+			for v := 0; v < n; v++ {
+				if !merged[v] && !inA[v] {
+					connectivity[v] += weight[selected][v]
+				}
+			}
+		}
+
+		if connectivity[last] < bestValue {
+			bestValue = connectivity[last]
+			bestGroup = append([]int(nil), groups[last]...)
+		}
+
+		// Merge the last node into the one selected before it
+		groups[previous] = append(groups[previous], groups[last]...)
+		merged[last] = true
+		for v := 0; v < n; v++ {
+			weight[previous][v] += weight[last][v]
+			weight[v][previous] = weight[previous][v]
+		}
+		weight[previous][previous] = 0
+	}
+
+	cut := Cut{
+		Value:      bestValue,
+		SourceSide: make(map[Node]bool),
+		SinkSide:   make(map[Node]bool),
+	}
+	for _, idx := range bestGroup {
+		cut.SinkSide[nodes[idx]] = true
+	}
+	for _, node := range nodes {
+		if !cut.SinkSide[node] {
+			cut.SourceSide[node] = true
+		}
+	}
+	for _, edge := range g.GetEdgeTuples() {
+		if cut.SourceSide[edge.Node1] && cut.SinkSide[edge.Node2] {
+			cut.CutEdges = append(cut.CutEdges, edge)
+		}
+	}
+	return cut, nil
+}
+
+// undirectedEdgeWeight looks up the weight of an undirected edge in either
+// orientation. Edges absent from the map have unit weight.
+func undirectedEdgeWeight(weights map[Edge]float64, edge Edge) float64 {
+	if value, ok := weights[edge]; ok {
+		return value
+	}
+	if value, ok := weights[Edge{Node1: edge.Node2, Node2: edge.Node1}]; ok {
+		return value
+	}
+	return 1
+}
+
+// LocalEdgeConnectivity returns the minimum number of edges that must be
+// removed to disconnect target from source, which equals the number of
+// edge-disjoint paths between them.
+func LocalEdgeConnectivity(g *UndirectedGraph, source Node, target Node) (int, error) {
+	flow, err := DinicMaxFlow(g.ToDirected(), nil, source, target)
+	if err != nil {
+		return 0, err
+	}
+	return int(math.Round(flow.Value)), nil
+}
+
+// EdgeConnectivity returns the minimum number of edges whose removal
+// disconnects the graph. Graphs with fewer than two nodes have connectivity 0.
+func EdgeConnectivity(g *UndirectedGraph) int {
+	nodes := sortedNodes(g.Nodes)
+	if len(nodes) < 2 {
+		return 0
+	}
+	// Any minimum cut separates nodes[0] from some other node
+	directed := g.ToDirected()
+	connectivity := math.MaxInt
+	for _, target := range nodes[1:] {
+		flow, _ := DinicMaxFlow(directed, nil, nodes[0], target)
+		connectivity = min(connectivity, int(math.Round(flow.Value)))
+	}
+	return connectivity
+}
+
+// LocalNodeConnectivity returns the number of internally node-disjoint paths
+// between source and target. For non-adjacent nodes this is the minimum number
+// of nodes that must be removed to disconnect them.
+//
+// Every node v is split into an "in" copy 2v and an "out" copy 2v+1 joined by
+// an arc of unit capacity, so that at most one path can cross it.
+func LocalNodeConnectivity(g *UndirectedGraph, source Node, target Node) (int, error) {
+	if !g.HasNode(source) {
+		return 0, fmt.Errorf("source node %d not in graph", source)
+	}
+	if !g.HasNode(target) {
+		return 0, fmt.Errorf("target node %d not in graph", target)
+	}
+	if source == target {
+		return 0, fmt.Errorf("source and target must be different nodes")
+	}
+	auxiliary := nodeSplitGraph(g)
+	flow, err := DinicMaxFlow(auxiliary, nil, 2*source+1, 2*target)
+	if err != nil {
+		return 0, err
+	}
+	return int(math.Round(flow.Value)), nil
+}
+
+func nodeSplitGraph(g *UndirectedGraph) *DirectedGraph {
+	auxiliary := &DirectedGraph{}
+	for node := range g.Nodes {
+		auxiliary.AddEdge(Edge{Node1: 2 * node, Node2: 2*node + 1})
+	}
+	for _, edge := range g.GetEdgeTuples() {
+		if edge.Node1 != edge.Node2 {
+			auxiliary.AddEdge(Edge{Node1: 2*edge.Node1 + 1, Node2: 2 * edge.Node2})
+		}
+	}
+	return auxiliary
+}
+
+// NodeConnectivity returns the minimum number of nodes whose removal
+// disconnects the graph (or leaves a single node). A complete graph on n nodes
+// has connectivity n-1 and a disconnected graph has connectivity 0.
+//
+// The implementation follows Esfahanian and Hakimi: only pairs involving a node
+// of minimum degree v, or pairs of non-adjacent neighbours of v, need to be
+// checked.
+func NodeConnectivity(g *UndirectedGraph) int {
+	nodes := sortedNodes(g.Nodes)
+	if len(nodes) < 2 {
+		return 0
+	}
+	if components := ConnectedComponents(g); len(components.ComponentsArray) > 1 {
+		return 0
+	}
+
+	adjacent := make(map[Edge]bool)
+	for _, edge := range g.GetEdgeTuples() {
+		adjacent[edge] = true
+	}
+	minDegreeNode := nodes[0]
+	for _, node := range nodes {
+		if g.NodeDegree(node) < g.NodeDegree(minDegreeNode) {
+			minDegreeNode = node
+		}
+	}
+
+	auxiliary := nodeSplitGraph(g)
+	localConnectivity := func(source Node, target Node) int {
+		flow, _ := DinicMaxFlow(auxiliary, nil, 2*source+1, 2*target)
+		return int(math.Round(flow.Value))
+	}
+
+	connectivity := len(nodes) - 1
+	for _, node := range nodes {
+		if node != minDegreeNode && !adjacent[Edge{Node1: minDegreeNode, Node2: node}] {
+			connectivity = min(connectivity, localConnectivity(minDegreeNode, node))
+		}
+	}
+	neighbours := g.Edges[minDegreeNode]
+	for i := 0; i < len(neighbours); i++ {
+		for j := i + 1; j < len(neighbours); j++ {
+			x, y := neighbours[i], neighbours[j]
+			if x != y && !adjacent[Edge{Node1: x, Node2: y}] {
+				connectivity = min(connectivity, localConnectivity(x, y))
+			}
+		}
+	}
+	return connectivity
+}
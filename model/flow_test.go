@@ -0,0 +1,161 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+// clrsFlowNetwork returns the flow network of CLRS figure 26.1, whose maximum flow is 23.
+func clrsFlowNetwork() (*DirectedGraph, map[Edge]float64) {
+	capacity := map[Edge]float64{
+		{0, 1}: 16, {0, 2}: 13, {1, 3}: 12, {2, 1}: 4,
+		{2, 4}: 14, {3, 2}: 9, {3, 5}: 20, {4, 3}: 7, {4, 5}: 4,
+	}
+	g := &DirectedGraph{}
+	for edge := range capacity {
+		g.AddEdge(edge)
+	}
+	return g, capacity
+}
+
+func TestMaxFlowAlgorithms(t *testing.T) {
+	algorithms := map[string]MaxFlowAlgorithm{
+		"EdmondsKarp": EdmondsKarpMaxFlow,
+		"Dinic":       DinicMaxFlow,
+		"PushRelabel": PushRelabelMaxFlow,
+	}
+
+	for name, algorithm := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			g, capacity := clrsFlowNetwork()
+			flow, err := algorithm(g, capacity, 0, 5)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if math.Abs(flow.Value-23) > 1e-9 {
+				t.Errorf("Expected flow value 23, but got %v", flow.Value)
+			}
+
+			// Capacity and conservation constraints
+			balance := make(map[Node]float64)
+			for edge, amount := range flow.EdgeFlows {
+				if amount > capacity[edge]+1e-9 {
+					t.Errorf("Flow %v on edge %v exceeds capacity %v", amount, edge, capacity[edge])
+				}
+				balance[edge.Node1] -= amount
+				balance[edge.Node2] += amount
+			}
+			for node, value := range balance {
+				if node != 0 && node != 5 && math.Abs(value) > 1e-9 {
+					t.Errorf("Flow is not conserved at node %d: %v", node, value)
+				}
+			}
+
+			// Unit capacities on a complete graph give n-1 edge-disjoint paths
+			flow, _ = algorithm(CompleteGraph(6).ToDirected(), nil, 0, 5)
+			if flow.Value != 5 {
+				t.Errorf("Expected flow value 5 on K6, but got %v", flow.Value)
+			}
+		})
+	}
+}
+
+func TestMaxFlow_Errors(t *testing.T) {
+	g, capacity := clrsFlowNetwork()
+	if _, err := DinicMaxFlow(g, capacity, 0, 42); err == nil {
+		t.Errorf("Expected an error for a missing sink")
+	}
+	if _, err := EdmondsKarpMaxFlow(g, capacity, 0, 0); err == nil {
+		t.Errorf("Expected an error when source equals sink")
+	}
+	capacity[Edge{0, 1}] = -1
+	if _, err := PushRelabelMaxFlow(g, capacity, 0, 5); err == nil {
+		t.Errorf("Expected an error for a negative capacity")
+	}
+}
+
+func TestMinimumCut(t *testing.T) {
+	g, capacity := clrsFlowNetwork()
+	cut, err := MinimumCut(g, capacity, 0, 5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if math.Abs(cut.Value-23) > 1e-9 {
+		t.Errorf("Expected cut value 23, but got %v", cut.Value)
+	}
+	if !cut.SourceSide[0] || !cut.SinkSide[5] {
+		t.Errorf("Terminals on the wrong side: %v / %v", cut.SourceSide, cut.SinkSide)
+	}
+	total := 0.0
+	for _, edge := range cut.CutEdges {
+		total += capacity[edge]
+	}
+	if math.Abs(total-cut.Value) > 1e-9 {
+		t.Errorf("Cut edges %v add up to %v instead of %v", cut.CutEdges, total, cut.Value)
+	}
+}
+
+func TestStoerWagnerMinimumCut(t *testing.T) {
+	// Two triangles joined by a single light edge
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}, {2, 3}})
+	weights := map[Edge]float64{
+		{0, 1}: 5, {1, 2}: 5, {2, 0}: 5,
+		{3, 4}: 5, {4, 5}: 5, {5, 3}: 5,
+		{2, 3}: 2,
+	}
+
+	cut, err := StoerWagnerMinimumCut(g, weights)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cut.Value != 2 {
+		t.Errorf("Expected cut value 2, but got %v", cut.Value)
+	}
+	if len(cut.SourceSide) != 3 || len(cut.SinkSide) != 3 || cut.SourceSide[2] == cut.SourceSide[3] {
+		t.Errorf("Unexpected partition %v / %v", cut.SourceSide, cut.SinkSide)
+	}
+	if len(cut.CutEdges) != 1 {
+		t.Errorf("Expected a single cut edge, but got %v", cut.CutEdges)
+	}
+
+	if _, err := StoerWagnerMinimumCut(TrivialGraph(), nil); err == nil {
+		t.Errorf("Expected an error for a graph with a single node")
+	}
+}
+
+func TestConnectivity(t *testing.T) {
+	testCases := []struct {
+		name             string
+		graph            *UndirectedGraph
+		nodeConnectivity int
+		edgeConnectivity int
+	}{
+		{name: "Complete graph", graph: CompleteGraph(5), nodeConnectivity: 4, edgeConnectivity: 4},
+		{name: "Cycle graph", graph: CycleGraph(6), nodeConnectivity: 2, edgeConnectivity: 2},
+		{name: "Path graph", graph: PathGraph(5), nodeConnectivity: 1, edgeConnectivity: 1},
+		{name: "Ladder graph", graph: LadderGraph(4), nodeConnectivity: 2, edgeConnectivity: 2},
+		{name: "Star graph", graph: StarGraph(5), nodeConnectivity: 1, edgeConnectivity: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := NodeConnectivity(tc.graph); got != tc.nodeConnectivity {
+				t.Errorf("Expected node connectivity %d, but got %d", tc.nodeConnectivity, got)
+			}
+			if got := EdgeConnectivity(tc.graph); got != tc.edgeConnectivity {
+				t.Errorf("Expected edge connectivity %d, but got %d", tc.edgeConnectivity, got)
+			}
+		})
+	}
+
+	// Two hubs sharing three leaves: 3 node-disjoint and 3 edge-disjoint paths
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {0, 3}, {4, 1}, {4, 2}, {4, 3}})
+	if local, _ := LocalNodeConnectivity(g, 0, 4); local != 3 {
+		t.Errorf("Expected local node connectivity 3, but got %d", local)
+	}
+	if local, _ := LocalEdgeConnectivity(g, 0, 4); local != 3 {
+		t.Errorf("Expected local edge connectivity 3, but got %d", local)
+	}
+}
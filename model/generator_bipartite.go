@@ -0,0 +1,57 @@
+package model
+
+import "fmt"
+
+// CompleteBipartiteGraph returns the complete bipartite graph K_{n1,n2}.
+// Nodes 0..n1-1 form the first set and nodes n1..n1+n2-1 the second one;
+// every node of the first set is connected to every node of the second set.
+//
+// Example:
+//
+//	// K_{2,3} has 5 nodes and 6 edges
+//	graph := CompleteBipartiteGraph(2, 3)
+func CompleteBipartiteGraph(n1 int, n2 int) *UndirectedGraph {
+	g := &UndirectedGraph{}
+	for i := 0; i < n1+n2; i++ {
+		g.AddNode(Node(i))
+	}
+	for i := 0; i < n1; i++ {
+		for j := n1; j < n1+n2; j++ {
+			g.AddEdge(Edge{
+				Node1: Node(i),
+				Node2: Node(j),
+			})
+		}
+	}
+	return g
+}
+
+// RandomBipartiteGraph returns a random bipartite graph G(n1, n2, p).
+// Nodes 0..n1-1 form the first set and nodes n1..n1+n2-1 the second one; each
+// of the n1*n2 possible edges between the sets is created independently with
+// probability p. The options select the source of randomness, e.g. WithSeed(42).
+func RandomBipartiteGraph(n1 int, n2 int, probabilityForEdgeCreation float64, options ...RandomOption) (*UndirectedGraph, error) {
+	if n1 < 0 || n2 < 0 {
+		return nil, fmt.Errorf("set sizes can't be negative")
+	}
+	if probabilityForEdgeCreation < 0 || probabilityForEdgeCreation > 1 {
+		return nil, fmt.Errorf("probability must be in [0, 1]")
+	}
+
+	random := newRandom(options...)
+	g := &UndirectedGraph{}
+	for i := 0; i < n1+n2; i++ {
+		g.AddNode(Node(i))
+	}
+	for i := 0; i < n1; i++ {
+		for j := n1; j < n1+n2; j++ {
+			if random.Float64() < probabilityForEdgeCreation {
+				g.AddEdge(Edge{
+					Node1: Node(i),
+					Node2: Node(j),
+				})
+			}
+		}
+	}
+	return g, nil
+}
@@ -263,4 +263,154 @@ func CirculantGraph(numberOfNodes int, offset int) *UndirectedGraph {
 	return g
 }
 
-// TODO: balanced tree, binomial tree, barbell graph, complete multipartite graph, dorogovtsev goltsev mendes graph, full rary tree
+// BalancedTree returns the perfectly balanced tree of the given height in which every internal node has branchingFactor children.
+// Nodes are numbered in breadth-first order from the root 0, so the children of node i are
+// branchingFactor*i+1 .. branchingFactor*i+branchingFactor.
+//
+// Example:
+//
+//	// A binary tree of height 2 has 1 + 2 + 4 = 7 nodes
+//	graph, _ := BalancedTree(2, 2)
+func BalancedTree(branchingFactor int, height int) (*UndirectedGraph, error) {
+	if branchingFactor < 1 {
+		return nil, fmt.Errorf("branching factor can't be < 1")
+	}
+	if height < 0 {
+		return nil, fmt.Errorf("height can't be < 0")
+	}
+	numberOfNodes, level := 1, 1
+	for h := 0; h < height; h++ {
+		level *= branchingFactor
+		numberOfNodes += level
+	}
+	return FullRaryTree(branchingFactor, numberOfNodes)
+}
+
+// FullRaryTree returns a tree of numberOfNodes nodes in which every internal node has branchingFactor children,
+// except possibly the last one. The levels are filled in breadth-first order, so the children of node i are
+// branchingFactor*i+1 .. branchingFactor*i+branchingFactor.
+func FullRaryTree(branchingFactor int, numberOfNodes int) (*UndirectedGraph, error) {
+	if branchingFactor < 1 {
+		return nil, fmt.Errorf("branching factor can't be < 1")
+	}
+	if numberOfNodes < 0 {
+		return nil, fmt.Errorf("number of nodes can't be < 0")
+	}
+	g := &UndirectedGraph{}
+	if numberOfNodes > 0 {
+		g.AddNode(0)
+	}
+	for i := 1; i < numberOfNodes; i++ {
+		g.AddEdge(Edge{
+			Node1: Node((i - 1) / branchingFactor),
+			Node2: Node(i),
+		})
+	}
+	return g, nil
+}
+
+// BinomialTree returns the binomial tree of the given order, which has 2^order nodes.
+// The tree of order k joins two trees of order k-1, numbered 0 .. 2^(k-1)-1 and 2^(k-1) .. 2^k-1,
+// with an edge between their roots 0 and 2^(k-1).
+func BinomialTree(order int) (*UndirectedGraph, error) {
+	if order < 0 {
+		return nil, fmt.Errorf("order can't be < 0")
+	}
+	var edges []Edge
+	for size := 1; size < 1<<order; size *= 2 {
+		// Copy the current tree with an offset, then link the two roots
+		for _, edge := range edges {
+			edges = append(edges, Edge{Node1: edge.Node1 + Node(size), Node2: edge.Node2 + Node(size)})
+		}
+		edges = append(edges, Edge{Node1: 0, Node2: Node(size)})
+	}
+	g := TrivialGraph()
+	for _, edge := range edges {
+		g.AddEdge(edge)
+	}
+	return g, nil
+}
+
+// BarbellGraph returns two complete graphs of completeGraphSize (at least 2) nodes connected by a path of pathGraphSize nodes.
+// The first complete graph has nodes 0 .. m1-1, the path m1 .. m1+m2-1 and the second complete graph
+// m1+m2 .. 2*m1+m2-1; with an empty path the two complete graphs are joined by a single bridge.
+func BarbellGraph(completeGraphSize int, pathGraphSize int) (*UndirectedGraph, error) {
+	if completeGraphSize < 2 {
+		return nil, fmt.Errorf("complete graph size can't be < 2")
+	}
+	if pathGraphSize < 0 {
+		return nil, fmt.Errorf("path graph size can't be < 0")
+	}
+	g := LollipopGraph(completeGraphSize, pathGraphSize)
+	offset := completeGraphSize + pathGraphSize
+	for i := 0; i < completeGraphSize; i++ {
+		for j := i + 1; j < completeGraphSize; j++ {
+			g.AddEdge(Edge{
+				Node1: Node(offset + i),
+				Node2: Node(offset + j),
+			})
+		}
+	}
+	g.AddEdge(Edge{
+		Node1: Node(offset - 1),
+		Node2: Node(offset),
+	})
+	return g, nil
+}
+
+// CompleteMultipartiteGraph returns the complete multipartite graph with the given partition sizes.
+// Nodes are numbered consecutively partition after partition, and every pair of nodes from
+// different partitions is connected.
+//
+// Example:
+//
+//	// K_{1,2,3} has 6 nodes and 1*2 + 1*3 + 2*3 = 11 edges
+//	graph, _ := CompleteMultipartiteGraph(1, 2, 3)
+func CompleteMultipartiteGraph(partitionSizes ...int) (*UndirectedGraph, error) {
+	g := &UndirectedGraph{}
+	starts := make([]int, len(partitionSizes)+1)
+	for p, size := range partitionSizes {
+		if size < 0 {
+			return nil, fmt.Errorf("partition size can't be < 0")
+		}
+		starts[p+1] = starts[p] + size
+	}
+	for i := 0; i < starts[len(partitionSizes)]; i++ {
+		g.AddNode(Node(i))
+	}
+	for p := range partitionSizes {
+		for i := starts[p]; i < starts[p+1]; i++ {
+			for j := starts[p+1]; j < starts[len(partitionSizes)]; j++ {
+				g.AddEdge(Edge{
+					Node1: Node(i),
+					Node2: Node(j),
+				})
+			}
+		}
+	}
+	return g, nil
+}
+
+// DorogovtsevGoltsevMendesGraph returns the deterministic scale-free graph of the given generation.
+// Generation 0 is a single edge between nodes 0 and 1; each following generation adds, for every
+// existing edge, a new node connected to both its endpoints. Generation n has (3^n+3)/2 nodes and 3^n edges.
+func DorogovtsevGoltsevMendesGraph(generation int) (*UndirectedGraph, error) {
+	if generation < 0 {
+		return nil, fmt.Errorf("generation can't be < 0")
+	}
+	g := &UndirectedGraph{}
+	edges := []Edge{{Node1: 0, Node2: 1}}
+	g.AddEdge(edges[0])
+	next := Node(2)
+	for i := 0; i < generation; i++ {
+		added := make([]Edge, 0, 3*len(edges))
+		for _, edge := range edges {
+			added = append(added, edge, Edge{Node1: edge.Node1, Node2: next}, Edge{Node1: edge.Node2, Node2: next})
+			g.AddEdge(Edge{Node1: edge.Node1, Node2: next})
+			g.AddEdge(Edge{Node1: edge.Node2, Node2: next})
+			next++
+		}
+		edges = added
+	}
+	return g, nil
+}
@@ -215,3 +215,156 @@ func validateGraph(t *testing.T, g *UndirectedGraph, expectedNodes map[Node]bool
 		t.Errorf("Graph mismatch, expected: %v, got: %v", expectedGraph, g)
 	}
 }
+
+func TestBalancedTree(t *testing.T) {
+	g, err := BalancedTree(2, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	validateGraph(t, g,
+		map[Node]bool{0: true, 1: true, 2: true, 3: true, 4: true, 5: true, 6: true},
+		map[Node][]Node{0: {1, 2}, 1: {0, 3, 4}, 2: {0, 5, 6}, 3: {1}, 4: {1}, 5: {2}, 6: {2}})
+
+	testCases := []struct {
+		branchingFactor int
+		height          int
+		expectedNodes   int
+	}{
+		{branchingFactor: 3, height: 3, expectedNodes: 40},
+		{branchingFactor: 1, height: 4, expectedNodes: 5},
+		{branchingFactor: 5, height: 0, expectedNodes: 1},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("r=%d,h=%d", tc.branchingFactor, tc.height), func(t *testing.T) {
+			g, _ := BalancedTree(tc.branchingFactor, tc.height)
+			if len(g.Nodes) != tc.expectedNodes || g.NumberOfEdges() != tc.expectedNodes-1 {
+				t.Errorf("Expected %d nodes and %d edges, but got %d and %d", tc.expectedNodes, tc.expectedNodes-1, len(g.Nodes), g.NumberOfEdges())
+			}
+		})
+	}
+
+	if _, err := BalancedTree(0, 2); err == nil {
+		t.Errorf("Expected an error for a branching factor of 0")
+	}
+	if _, err := BalancedTree(2, -1); err == nil {
+		t.Errorf("Expected an error for a negative height")
+	}
+}
+
+func TestFullRaryTree(t *testing.T) {
+	g, err := FullRaryTree(3, 6)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	validateGraph(t, g,
+		map[Node]bool{0: true, 1: true, 2: true, 3: true, 4: true, 5: true},
+		map[Node][]Node{0: {1, 2, 3}, 1: {0, 4, 5}, 2: {0}, 3: {0}, 4: {1}, 5: {1}})
+
+	if g, _ := FullRaryTree(2, 0); len(g.Nodes) != 0 {
+		t.Errorf("Expected an empty tree, but got %v", g)
+	}
+	if _, err := FullRaryTree(2, -1); err == nil {
+		t.Errorf("Expected an error for a negative number of nodes")
+	}
+}
+
+func TestBinomialTree(t *testing.T) {
+	g, err := BinomialTree(3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	validateGraph(t, g,
+		map[Node]bool{0: true, 1: true, 2: true, 3: true, 4: true, 5: true, 6: true, 7: true},
+		map[Node][]Node{0: {1, 2, 4}, 1: {0}, 2: {3, 0}, 3: {2}, 4: {5, 6, 0}, 5: {4}, 6: {7, 4}, 7: {6}})
+
+	// The binomial tree of order k has C(k, d) nodes at depth d, so the root has degree k
+	g, _ = BinomialTree(6)
+	if len(g.Nodes) != 64 || g.NumberOfEdges() != 63 || g.NodeDegree(0) != 6 {
+		t.Errorf("Unexpected binomial tree of order 6: %d nodes, %d edges, root degree %d", len(g.Nodes), g.NumberOfEdges(), g.NodeDegree(0))
+	}
+	if g, _ := BinomialTree(0); len(g.Nodes) != 1 {
+		t.Errorf("Expected a single node, but got %v", g)
+	}
+	if _, err := BinomialTree(-1); err == nil {
+		t.Errorf("Expected an error for a negative order")
+	}
+}
+
+func TestBarbellGraph(t *testing.T) {
+	g, err := BarbellGraph(3, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	validateGraph(t, g,
+		map[Node]bool{0: true, 1: true, 2: true, 3: true, 4: true, 5: true, 6: true},
+		map[Node][]Node{0: {1, 2}, 1: {0, 2}, 2: {0, 1, 3}, 3: {2, 4}, 4: {5, 6, 3}, 5: {4, 6}, 6: {4, 5}})
+
+	g, _ = BarbellGraph(4, 0)
+	if len(g.Nodes) != 8 || g.NumberOfEdges() != 13 || !g.HasEdge(Edge{Node1: 3, Node2: 4}) {
+		t.Errorf("Expected two K4 joined by the bridge 3-4, but got %v", g)
+	}
+
+	testCases := []struct {
+		completeGraphSize int
+		pathGraphSize     int
+		expectedError     string
+	}{
+		{completeGraphSize: 1, pathGraphSize: 2, expectedError: "complete graph size can't be < 2"},
+		{completeGraphSize: 3, pathGraphSize: -1, expectedError: "path graph size can't be < 0"},
+	}
+	for _, tc := range testCases {
+		g, err := BarbellGraph(tc.completeGraphSize, tc.pathGraphSize)
+		if err == nil || err.Error() != tc.expectedError || g != nil {
+			t.Errorf("Expected error %q, but got %v", tc.expectedError, err)
+		}
+	}
+}
+
+func TestCompleteMultipartiteGraph(t *testing.T) {
+	g, err := CompleteMultipartiteGraph(1, 2, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(g.Nodes) != 6 || g.NumberOfEdges() != 11 {
+		t.Errorf("Expected 6 nodes and 11 edges, but got %d and %d", len(g.Nodes), g.NumberOfEdges())
+	}
+	if g.HasEdge(Edge{Node1: 1, Node2: 2}) || g.HasEdge(Edge{Node1: 3, Node2: 5}) || !g.HasEdge(Edge{Node1: 2, Node2: 3}) {
+		t.Errorf("Edges do not follow the partitions: %v", g)
+	}
+
+	// Two parts give the complete bipartite graph, singleton parts the complete graph
+	g, _ = CompleteMultipartiteGraph(2, 3)
+	if !g.Equals(CompleteBipartiteGraph(2, 3)) {
+		t.Errorf("Expected K_{2,3}, but got %v", g)
+	}
+	g, _ = CompleteMultipartiteGraph(1, 1, 1, 1)
+	if !g.Equals(CompleteGraph(4)) {
+		t.Errorf("Expected K4, but got %v", g)
+	}
+	if g, _ := CompleteMultipartiteGraph(3); len(g.Nodes) != 3 || g.NumberOfEdges() != 0 {
+		t.Errorf("Expected 3 isolated nodes, but got %v", g)
+	}
+	if _, err := CompleteMultipartiteGraph(2, -1); err == nil {
+		t.Errorf("Expected an error for a negative partition size")
+	}
+}
+
+func TestDorogovtsevGoltsevMendesGraph(t *testing.T) {
+	g, err := DorogovtsevGoltsevMendesGraph(1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !g.Equals(CompleteGraph(3)) {
+		t.Errorf("Expected a triangle, but got %v", g)
+	}
+
+	for generation, power := 0, 1; generation <= 5; generation, power = generation+1, power*3 {
+		g, _ := DorogovtsevGoltsevMendesGraph(generation)
+		if len(g.Nodes) != (power+3)/2 || g.NumberOfEdges() != power {
+			t.Errorf("Generation %d: expected %d nodes and %d edges, but got %d and %d", generation, (power+3)/2, power, len(g.Nodes), g.NumberOfEdges())
+		}
+	}
+	if _, err := DorogovtsevGoltsevMendesGraph(-1); err == nil {
+		t.Errorf("Expected an error for a negative generation")
+	}
+}
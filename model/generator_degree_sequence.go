@@ -0,0 +1,357 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// checkDegreeSequence returns an error if a degree sequence has a negative entry or an odd sum.
+func checkDegreeSequence(sequence []int) error {
+	sum := 0
+	for node, degree := range sequence {
+		if degree < 0 {
+			return fmt.Errorf("degree of node %d can't be < 0", node)
+		}
+		sum += degree
+	}
+	if sum%2 != 0 {
+		return fmt.Errorf("sum of degrees must be even, got %d", sum)
+	}
+	return nil
+}
+
+/*
+IsGraphical reports whether a sequence is the degree sequence of a simple graph.
+
+Parameters:
+- sequence: The degree of every node.
+
+Returns:
+- graphical: True if some graph without self-loops and parallel edges has exactly these degrees.
+
+Description:
+By the Erdős-Gallai theorem, a non-increasing sequence d1 >= ... >= dn of
+non-negative integers with an even sum is graphical if and only if, for every
+k, d1 + ... + dk <= k(k-1) + min(d(k+1), k) + ... + min(dn, k). The check
+takes O(n log n) time.
+
+Example:
+
+	fmt.Println(IsGraphical([]int{3, 3, 2, 2, 2})) // Output: true
+	fmt.Println(IsGraphical([]int{4, 4, 1, 1})) // Output: false
+*/
+func IsGraphical(sequence []int) bool {
+	if checkDegreeSequence(sequence) != nil {
+		return false
+	}
+	degrees := append([]int(nil), sequence...)
+	sort.Sort(sort.Reverse(sort.IntSlice(degrees)))
+
+	n := len(degrees)
+	// suffix[i] is the sum of degrees[i:]
+	suffix := make([]int, n+1)
+	for i := n - 1; i >= 0; i-- {
+		suffix[i] = suffix[i+1] + degrees[i]
+	}
+	left := 0
+	// tail is the first index whose degree is < k, so min(d, k) = k before it and d from it on
+	tail := n
+	for k := 1; k <= n; k++ {
+		left += degrees[k-1]
+		for tail > 0 && degrees[tail-1] < k {
+			tail--
+		}
+		right := k * (k - 1)
+		if tail > k {
+			right += (tail-k)*k + suffix[tail]
+		} else {
+			right += suffix[k]
+		}
+		if left > right {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+ConfigurationModel returns a random multigraph with the given degree sequence.
+
+Parameters:
+- sequence: The degree of every node 0 .. n-1; the sum must be even.
+- options: Optional source of randomness, e.g. WithSeed(42) for a reproducible graph.
+
+Returns:
+- graph: A multigraph in which node i has degree sequence[i], a self-loop counting twice.
+- error: If a degree is negative or the sum is odd.
+
+Description:
+Every node i gets sequence[i] stubs and the stubs are matched uniformly at
+random (Bender and Canfield, Bollobás). The result may contain self-loops and
+parallel edges; ErasedConfigurationModel removes them.
+*/
+func ConfigurationModel(sequence []int, options ...RandomOption) (*UndirectedGraph, error) {
+	if err := checkDegreeSequence(sequence); err != nil {
+		return nil, err
+	}
+	random := newRandom(options...)
+	g := &UndirectedGraph{
+		Nodes: make(map[Node]bool, len(sequence)),
+		Edges: make(map[Node][]Node, len(sequence)),
+	}
+	var stubs []Node
+	for node, degree := range sequence {
+		g.AddNode(Node(node))
+		for i := 0; i < degree; i++ {
+			stubs = append(stubs, Node(node))
+		}
+	}
+	random.Shuffle(len(stubs), func(i, j int) { stubs[i], stubs[j] = stubs[j], stubs[i] })
+	for i := 0; i < len(stubs); i += 2 {
+		g.AddEdge(Edge{Node1: stubs[i], Node2: stubs[i+1]})
+	}
+	return g, nil
+}
+
+// ErasedConfigurationModel returns the simple graph obtained from ConfigurationModel by erasing self-loops and
+// merging parallel edges. Degrees can only decrease, and the error is small when the maximum degree is small
+// compared with the square root of the number of edges.
+func ErasedConfigurationModel(sequence []int, options ...RandomOption) (*UndirectedGraph, error) {
+	multigraph, err := ConfigurationModel(sequence, options...)
+	if err != nil {
+		return nil, err
+	}
+	return simpleCopy(multigraph), nil
+}
+
+/*
+HavelHakimiGraph returns a simple graph with the given degree sequence, built deterministically.
+
+Parameters:
+- sequence: The degree of every node 0 .. n-1.
+
+Returns:
+- graph: A simple graph in which node i has degree sequence[i].
+- error: If the sequence is not graphical.
+
+Description:
+The node with the largest remaining degree d is repeatedly connected to the d
+nodes with the next largest remaining degrees (Havel and Hakimi); ties are
+broken by the smallest node. This succeeds exactly for graphical sequences.
+
+Example:
+
+	// The only graph with degrees 3, 3, 3, 3 is K4
+	g, _ := HavelHakimiGraph([]int{3, 3, 3, 3})
+	fmt.Println(g.NumberOfEdges()) // Output: 6
+*/
+func HavelHakimiGraph(sequence []int) (*UndirectedGraph, error) {
+	if !IsGraphical(sequence) {
+		return nil, fmt.Errorf("degree sequence %v is not graphical", sequence)
+	}
+	g := &UndirectedGraph{
+		Nodes: make(map[Node]bool, len(sequence)),
+		Edges: make(map[Node][]Node, len(sequence)),
+	}
+	remaining := append([]int(nil), sequence...)
+	order := make([]int, len(sequence))
+	for node := range sequence {
+		g.AddNode(Node(node))
+		order[node] = node
+	}
+	for {
+		sort.SliceStable(order, func(i, j int) bool {
+			if remaining[order[i]] != remaining[order[j]] {
+				return remaining[order[i]] > remaining[order[j]]
+			}
+			return order[i] < order[j]
+		})
+		if len(order) == 0 || remaining[order[0]] == 0 {
+			return g, nil
+		}
+		source := order[0]
+		for _, target := range order[1 : remaining[source]+1] {
+			g.AddEdge(Edge{Node1: Node(source), Node2: Node(target)})
+			remaining[target]--
+		}
+		remaining[source] = 0
+	}
+}
+
+/*
+ExpectedDegreeGraph returns a random graph with given expected degrees, also known as the Chung-Lu model.
+
+Parameters:
+- weights: The expected degree w[i] of every node 0 .. n-1.
+- selfLoops: Whether self-loops are allowed.
+- options: Optional source of randomness, e.g. WithSeed(42) for a reproducible graph.
+
+Returns:
+- graph: A simple graph (apart from self-loops) in which nodes u and v are adjacent with probability min(w[u] w[v] / Σw, 1).
+- error: If a weight is negative.
+
+Description:
+When max(w)^2 < Σw, the expected degree of node u is w[u], minus w[u]^2 / Σw
+without self-loops. Following Miller and Hagberg, nodes are sorted by
+decreasing weight and the candidates of every node are skipped over
+geometrically, so the graph is built in O(n + m) expected time.
+*/
+func ExpectedDegreeGraph(weights []float64, selfLoops bool, options ...RandomOption) (*UndirectedGraph, error) {
+	n := len(weights)
+	sum := 0.0
+	for node, weight := range weights {
+		if weight < 0 {
+			return nil, fmt.Errorf("weight of node %d can't be < 0", node)
+		}
+		sum += weight
+	}
+	random := newRandom(options...)
+	g := &UndirectedGraph{
+		Nodes: make(map[Node]bool, n),
+		Edges: make(map[Node][]Node, n),
+	}
+	for node := 0; node < n; node++ {
+		g.AddNode(Node(node))
+	}
+	if sum == 0 {
+		return g, nil
+	}
+
+	order := make([]int, n)
+	for node := range order {
+		order[node] = node
+	}
+	sort.SliceStable(order, func(i, j int) bool { return weights[order[i]] > weights[order[j]] })
+
+	last := n
+	if !selfLoops {
+		last--
+	}
+	for u := 0; u < last; u++ {
+		v := u
+		if !selfLoops {
+			v++
+		}
+		factor := weights[order[u]] / sum
+		p := math.Min(weights[order[v]]*factor, 1)
+		for v < n && p > 0 {
+			if p != 1 {
+				// Skip the geometrically many candidates rejected at probability p; the skip may exceed any int
+				skip := math.Floor(math.Log(1-random.Float64()) / math.Log(1-p))
+				if skip >= float64(n-v) {
+					break
+				}
+				v += int(skip)
+			}
+			// Weights decrease along the order, so q <= p and the candidate is kept with probability q/p
+			q := math.Min(weights[order[v]]*factor, 1)
+			if random.Float64() < q/p {
+				g.AddEdge(Edge{Node1: Node(order[u]), Node2: Node(order[v])})
+			}
+			v++
+			p = q
+		}
+	}
+	return g, nil
+}
+
+/*
+RandomRegularGraph returns a graph chosen nearly uniformly at random among the d-regular graphs on n nodes.
+
+Parameters:
+- degree: The degree d of every node; 0 <= d < n.
+- numberOfNodes: The number of nodes n; n*d must be even.
+- options: Optional source of randomness, e.g. WithSeed(42) for a reproducible graph.
+
+Returns:
+- graph: A simple d-regular graph on nodes 0 .. n-1.
+- error: If no such graph exists.
+
+Description:
+The stubs are paired at random as in the configuration model, but pairs that
+would form a self-loop or a parallel edge are put back and paired again
+(Steger and Wormald). If the remaining stubs can't form a new edge, the
+process restarts. For d = O(n^(1/3)) the distribution is asymptotically
+uniform and the expected running time is O(nd^2).
+*/
+func RandomRegularGraph(degree int, numberOfNodes int, options ...RandomOption) (*UndirectedGraph, error) {
+	if degree < 0 || degree >= numberOfNodes {
+		return nil, fmt.Errorf("degree must be in [0, %d), got %d", numberOfNodes, degree)
+	}
+	if numberOfNodes*degree%2 != 0 {
+		return nil, fmt.Errorf("n * d must be even")
+	}
+	random := newRandom(options...)
+
+	// tryCreation pairs the stubs, returning nil when the process gets stuck
+	tryCreation := func() map[Edge]bool {
+		edges := make(map[Edge]bool, numberOfNodes*degree/2)
+		var stubs []Node
+		for i := 0; i < degree; i++ {
+			for node := 0; node < numberOfNodes; node++ {
+				stubs = append(stubs, Node(node))
+			}
+		}
+		for len(stubs) > 0 {
+			random.Shuffle(len(stubs), func(i, j int) { stubs[i], stubs[j] = stubs[j], stubs[i] })
+			potential := make(map[Node]int)
+			for i := 0; i < len(stubs); i += 2 {
+				edge := Edge{Node1: min(stubs[i], stubs[i+1]), Node2: max(stubs[i], stubs[i+1])}
+				if edge.Node1 != edge.Node2 && !edges[edge] {
+					edges[edge] = true
+				} else {
+					potential[edge.Node1]++
+					potential[edge.Node2]++
+				}
+			}
+
+			nodes := make([]Node, 0, len(potential))
+			for node := range potential {
+				nodes = append(nodes, node)
+			}
+			sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+			suitable := len(nodes) == 0
+			for i := 0; i < len(nodes) && !suitable; i++ {
+				for j := i + 1; j < len(nodes); j++ {
+					if !edges[Edge{Node1: nodes[i], Node2: nodes[j]}] {
+						suitable = true
+						break
+					}
+				}
+			}
+			if !suitable {
+				return nil
+			}
+
+			stubs = stubs[:0]
+			for _, node := range nodes {
+				for i := 0; i < potential[node]; i++ {
+					stubs = append(stubs, node)
+				}
+			}
+		}
+		return edges
+	}
+
+	edges := tryCreation()
+	for edges == nil {
+		edges = tryCreation()
+	}
+	sorted := make([]Edge, 0, len(edges))
+	for edge := range edges {
+		sorted = append(sorted, edge)
+	}
+	sortEdges(sorted)
+	g := &UndirectedGraph{
+		Nodes: make(map[Node]bool, numberOfNodes),
+		Edges: make(map[Node][]Node, numberOfNodes),
+	}
+	for node := 0; node < numberOfNodes; node++ {
+		g.AddNode(Node(node))
+	}
+	for _, edge := range sorted {
+		g.AddEdge(edge)
+	}
+	return g, nil
+}
@@ -0,0 +1,211 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+)
+
+// degreesOf returns the degree of every node 0 .. n-1, a self-loop counting twice.
+func degreesOf(g *UndirectedGraph, n int) []int {
+	degrees := make([]int, n)
+	for node := range degrees {
+		degrees[node] = g.NodeDegree(Node(node))
+	}
+	return degrees
+}
+
+func TestIsGraphical(t *testing.T) {
+	tests := []struct {
+		sequence  []int
+		graphical bool
+	}{
+		{[]int{}, true},
+		{[]int{0}, true},
+		{[]int{1, 1}, true},
+		{[]int{1}, false},
+		{[]int{2, 2, 2}, true},
+		{[]int{3, 3, 2, 2, 2}, true},
+		{[]int{5, 1, 1, 1, 1, 1}, true},
+		{[]int{4, 4, 1, 1}, false},
+		{[]int{3, 3, 3, 1}, false},
+		{[]int{3, 1}, false},
+		{[]int{-1, 1}, false},
+	}
+	for _, test := range tests {
+		if graphical := IsGraphical(test.sequence); graphical != test.graphical {
+			t.Errorf("Expected IsGraphical(%v) to be %v, but got %v", test.sequence, test.graphical, graphical)
+		}
+	}
+}
+
+func TestIsGraphicalMatchesEnumeration(t *testing.T) {
+	// Collect the degree sequences of all 2^10 graphs on 5 nodes
+	n := 5
+	var pairs [][2]int
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			pairs = append(pairs, [2]int{u, v})
+		}
+	}
+	sequences := make(map[string]bool)
+	for subset := 0; subset < 1<<len(pairs); subset++ {
+		degrees := make([]int, n)
+		for i, pair := range pairs {
+			if subset&(1<<i) != 0 {
+				degrees[pair[0]]++
+				degrees[pair[1]]++
+			}
+		}
+		sequences[fmt.Sprint(degrees)] = true
+	}
+
+	sequence := make([]int, n)
+	for code := 0; code < 3125; code++ {
+		for i, rest := 0, code; i < n; i, rest = i+1, rest/5 {
+			sequence[i] = rest % 5
+		}
+		expected := sequences[fmt.Sprint(sequence)]
+		if IsGraphical(sequence) != expected {
+			t.Errorf("Expected IsGraphical(%v) to be %v", sequence, expected)
+		}
+		g, err := HavelHakimiGraph(sequence)
+		if expected != (err == nil) {
+			t.Fatalf("Expected HavelHakimiGraph(%v) to succeed: %v, but got error %v", sequence, expected, err)
+		}
+		if err == nil && (!isSimpleGraph(g) || len(g.Nodes) != n || fmt.Sprint(degreesOf(g, n)) != fmt.Sprint(sequence)) {
+			t.Errorf("Expected a simple graph with degrees %v, but got %v", sequence, g)
+		}
+	}
+}
+
+func TestConfigurationModel(t *testing.T) {
+	sequence := []int{5, 4, 3, 3, 2, 2, 2, 1, 1, 1}
+	g, err := ConfigurationModel(sequence, WithSeed(1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fmt.Sprint(degreesOf(g, len(sequence))) != fmt.Sprint(sequence) || g.NumberOfEdges() != 12 {
+		t.Errorf("Expected degrees %v and 12 edges, but got %v and %d", sequence, degreesOf(g, len(sequence)), g.NumberOfEdges())
+	}
+
+	// Over many matchings, some contain self-loops or parallel edges, which the erased variant removes
+	multigraphs := 0
+	for seed := int64(0); seed < 50; seed++ {
+		multigraph, _ := ConfigurationModel(sequence, WithSeed(seed))
+		erased, _ := ErasedConfigurationModel(sequence, WithSeed(seed))
+		if !isSimpleGraph(multigraph) {
+			multigraphs++
+		}
+		if !isSimpleGraph(erased) || len(erased.Nodes) != len(sequence) {
+			t.Fatalf("Expected a simple graph on %d nodes, but got %v", len(sequence), erased)
+		}
+		for node, degree := range sequence {
+			if erased.NodeDegree(Node(node)) > degree {
+				t.Errorf("Expected node %d to have degree <= %d, but got %d", node, degree, erased.NodeDegree(Node(node)))
+			}
+		}
+	}
+	if multigraphs == 0 {
+		t.Errorf("Expected some matchings to contain self-loops or parallel edges")
+	}
+
+	if _, err := ConfigurationModel([]int{1, 2}); err == nil {
+		t.Errorf("Expected an error for an odd degree sum")
+	}
+	if _, err := ErasedConfigurationModel([]int{-2, 2}); err == nil {
+		t.Errorf("Expected an error for a negative degree")
+	}
+}
+
+func TestExpectedDegreeGraph(t *testing.T) {
+	// 1000 nodes of weight 5 and one of weight 50; without self-loops node i has expected degree w[i] - w[i]²/Σw
+	weights := make([]float64, 1001)
+	for i := range weights {
+		weights[i] = 5
+	}
+	weights[1000] = 50
+	total, hub := 0, 0
+	for seed := int64(0); seed < 20; seed++ {
+		g, err := ExpectedDegreeGraph(weights, false, WithSeed(seed))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(g.Nodes) != 1001 || !isSimpleGraph(g) {
+			t.Fatalf("Expected a simple graph on 1001 nodes")
+		}
+		for node := 0; node < 1000; node++ {
+			total += g.NodeDegree(Node(node))
+		}
+		hub += g.NodeDegree(1000)
+	}
+	if mean := float64(total) / 20000; mean < 4.8 || mean > 5.2 {
+		t.Errorf("Expected a mean degree of about 5, but got %v", mean)
+	}
+	if mean := float64(hub) / 20; mean < 46 || mean > 53 {
+		t.Errorf("Expected the hub to have a mean degree of about 49.5, but got %v", mean)
+	}
+
+	// Weights large enough make every pair, and with self-loops every node, adjacent
+	complete, _ := ExpectedDegreeGraph([]float64{10, 10, 10, 10}, false, WithSeed(1))
+	if complete.NumberOfEdges() != 6 || !isSimpleGraph(complete) {
+		t.Errorf("Expected K4, but got %v", complete)
+	}
+	looped, _ := ExpectedDegreeGraph([]float64{10, 10, 10, 10}, true, WithSeed(1))
+	if looped.NumberOfEdges() != 10 || !hasSelfLoop(looped, 0) {
+		t.Errorf("Expected K4 with self-loops, but got %v", looped)
+	}
+	if empty, _ := ExpectedDegreeGraph([]float64{0, 0, 0}, false); len(empty.Nodes) != 3 || empty.NumberOfEdges() != 0 {
+		t.Errorf("Expected 3 isolated nodes, but got %v", empty)
+	}
+	if _, err := ExpectedDegreeGraph([]float64{1, -1}, false); err == nil {
+		t.Errorf("Expected an error for a negative weight")
+	}
+}
+
+func TestRandomRegularGraph(t *testing.T) {
+	for _, parameters := range [][2]int{{3, 20}, {4, 9}, {0, 5}, {6, 7}, {2, 100}} {
+		degree, n := parameters[0], parameters[1]
+		g, err := RandomRegularGraph(degree, n, WithSeed(int64(n)))
+		if err != nil {
+			t.Fatalf("Unexpected error for d = %d and n = %d: %v", degree, n, err)
+		}
+		if len(g.Nodes) != n || g.NumberOfEdges() != degree*n/2 || !isSimpleGraph(g) {
+			t.Errorf("Expected a simple graph with %d nodes and %d edges, but got %d and %d", n, degree*n/2, len(g.Nodes), g.NumberOfEdges())
+		}
+		for node := range g.Nodes {
+			if g.NodeDegree(node) != degree {
+				t.Errorf("Expected node %d to have degree %d, but got %d", node, degree, g.NodeDegree(node))
+			}
+		}
+	}
+
+	// Both 3-regular graphs on 6 nodes, K_{3,3} and the prism, must appear
+	counts := make(map[bool]int)
+	for seed := int64(0); seed < 200; seed++ {
+		g, _ := RandomRegularGraph(3, 6, WithSeed(seed))
+		counts[IsBipartite(g)]++
+	}
+	if counts[true] == 0 || counts[false] == 0 {
+		t.Errorf("Expected both K_{3,3} and the prism, but got %v", counts)
+	}
+
+	for _, parameters := range [][2]int{{3, 5}, {5, 5}, {-1, 4}} {
+		if _, err := RandomRegularGraph(parameters[0], parameters[1]); err == nil {
+			t.Errorf("Expected an error for d = %d and n = %d", parameters[0], parameters[1])
+		}
+	}
+}
+
+func TestDegreeSequenceGeneratorsAreReproducible(t *testing.T) {
+	sequence := []int{3, 3, 2, 2, 2, 1, 1}
+	c1, _ := ConfigurationModel(sequence, WithSeed(5))
+	c2, _ := ConfigurationModel(sequence, WithSeed(5))
+	r1, _ := RandomRegularGraph(3, 30, WithSeed(5))
+	r2, _ := RandomRegularGraph(3, 30, WithSeed(5))
+	weights := []float64{3, 3, 2, 2, 2, 1, 1}
+	e1, _ := ExpectedDegreeGraph(weights, true, WithSeed(5))
+	e2, _ := ExpectedDegreeGraph(weights, true, WithSeed(5))
+	if !c1.Equals(c2) || !r1.Equals(r2) || !e1.Equals(e2) {
+		t.Errorf("Expected the same graphs for the same seed")
+	}
+}
@@ -0,0 +1,182 @@
+package model
+
+import "fmt"
+
+// GridNode returns the node of a lattice at the given coordinates.
+// Lattice nodes are numbered in row-major order: for dimensions [d0, d1, ..., dk] the node at
+// coordinates [c0, c1, ..., ck] is ((c0*d1 + c1)*d2 + ...)*dk + ck, so the last coordinate varies fastest.
+//
+// Example:
+//
+//	node, _ := GridNode([]int{1, 2}, []int{3, 4})
+//	fmt.Println(node) // Output: 6
+func GridNode(coordinates []int, dimensions []int) (Node, error) {
+	if len(coordinates) != len(dimensions) {
+		return 0, fmt.Errorf("expected %d coordinates, got %d", len(dimensions), len(coordinates))
+	}
+	node := 0
+	for axis, coordinate := range coordinates {
+		if coordinate < 0 || coordinate >= dimensions[axis] {
+			return 0, fmt.Errorf("coordinate %d is out of range [0, %d)", coordinate, dimensions[axis])
+		}
+		node = node*dimensions[axis] + coordinate
+	}
+	return Node(node), nil
+}
+
+// GridCoordinates returns the coordinates of a lattice node, reversing GridNode.
+//
+// Example:
+//
+//	coordinates, _ := GridCoordinates(6, []int{3, 4})
+//	fmt.Println(coordinates) // Output: [1 2]
+func GridCoordinates(node Node, dimensions []int) ([]int, error) {
+	size, err := latticeSize(dimensions)
+	if err != nil {
+		return nil, err
+	}
+	if node < 0 || int(node) >= size {
+		return nil, fmt.Errorf("node %d is out of range [0, %d)", node, size)
+	}
+	coordinates := make([]int, len(dimensions))
+	remainder := int(node)
+	for axis := len(dimensions) - 1; axis >= 0; axis-- {
+		coordinates[axis] = remainder % dimensions[axis]
+		remainder /= dimensions[axis]
+	}
+	return coordinates, nil
+}
+
+// latticeSize returns the number of nodes of a lattice with the given dimensions.
+func latticeSize(dimensions []int) (int, error) {
+	size := 1
+	for _, dimension := range dimensions {
+		if dimension < 0 {
+			return 0, fmt.Errorf("dimension can't be < 0")
+		}
+		size *= dimension
+	}
+	return size, nil
+}
+
+// GridGraph returns the n-dimensional grid graph with the given dimensions.
+// Two nodes are connected when their coordinates differ by 1 along exactly one axis; if periodic is true
+// the first and last nodes along every axis of length at least 3 are connected too, which gives a torus.
+// Nodes map to coordinates with GridCoordinates.
+//
+// Example:
+//
+//	// A 3x3x3 cube has 27 nodes and 3 * 3*3*2 = 54 edges
+//	graph, _ := GridGraph([]int{3, 3, 3}, false)
+func GridGraph(dimensions []int, periodic bool) (*UndirectedGraph, error) {
+	size, err := latticeSize(dimensions)
+	if err != nil {
+		return nil, err
+	}
+	g := &UndirectedGraph{}
+	for i := 0; i < size; i++ {
+		g.AddNode(Node(i))
+	}
+	stride := 1
+	for axis := len(dimensions) - 1; axis >= 0; axis-- {
+		for i := 0; i < size; i++ {
+			coordinate := (i / stride) % dimensions[axis]
+			if coordinate+1 < dimensions[axis] {
+				g.AddEdge(Edge{
+					Node1: Node(i),
+					Node2: Node(i + stride),
+				})
+			} else if periodic && dimensions[axis] >= 3 {
+				g.AddEdge(Edge{
+					Node1: Node(i),
+					Node2: Node(i - coordinate*stride),
+				})
+			}
+		}
+		stride *= dimensions[axis]
+	}
+	return g, nil
+}
+
+// Grid2DGraph returns the rows x columns grid graph, in which node row*columns+column is connected to its
+// horizontal and vertical neighbours. LadderGraph is the 2 x n grid with another numbering.
+func Grid2DGraph(rows int, columns int) (*UndirectedGraph, error) {
+	if rows < 0 || columns < 0 {
+		return nil, fmt.Errorf("rows and columns can't be < 0")
+	}
+	return GridGraph([]int{rows, columns}, false)
+}
+
+// TorusGraph returns the rows x columns grid graph with periodic boundaries, in which every node has degree 4.
+// Nodes are numbered row*columns+column as in Grid2DGraph.
+func TorusGraph(rows int, columns int) (*UndirectedGraph, error) {
+	if rows < 3 || columns < 3 {
+		return nil, fmt.Errorf("rows and columns can't be < 3")
+	}
+	return GridGraph([]int{rows, columns}, true)
+}
+
+// TriangularLatticeGraph returns a rhombus-shaped patch of the triangular lattice with rows x columns nodes.
+// Node row*columns+column is connected to its horizontal and vertical neighbours as in Grid2DGraph, and to
+// the diagonal neighbours (row+1, column+1) and (row-1, column-1), so inner nodes have degree 6.
+func TriangularLatticeGraph(rows int, columns int) (*UndirectedGraph, error) {
+	g, err := Grid2DGraph(rows, columns)
+	if err != nil {
+		return nil, err
+	}
+	for row := 0; row+1 < rows; row++ {
+		for column := 0; column+1 < columns; column++ {
+			g.AddEdge(Edge{
+				Node1: Node(row*columns + column),
+				Node2: Node((row+1)*columns + column + 1),
+			})
+		}
+	}
+	return g, nil
+}
+
+// HexagonalLatticeGraph returns a patch of the honeycomb lattice made of rows x columns hexagons.
+// The nodes lie on a (columns+1) x (2*rows+2) brick wall: node i*(2*rows+2)+j has coordinates (i, j),
+// see GridCoordinates, and is connected to (i, j+1) and, when i and j have the same parity, to (i+1, j).
+// The two corner nodes that would only have one neighbour are left out, which leaves
+// 2*(rows+1)*(columns+1)-2 nodes.
+func HexagonalLatticeGraph(rows int, columns int) (*UndirectedGraph, error) {
+	if rows < 1 || columns < 1 {
+		return nil, fmt.Errorf("rows and columns can't be < 1")
+	}
+	height := 2*rows + 2
+	g := &UndirectedGraph{}
+	for i := 0; i <= columns; i++ {
+		for j := 0; j < height; j++ {
+			if j+1 < height {
+				g.AddEdge(Edge{
+					Node1: Node(i*height + j),
+					Node2: Node(i*height + j + 1),
+				})
+			}
+			if i < columns && i%2 == j%2 {
+				g.AddEdge(Edge{
+					Node1: Node(i*height + j),
+					Node2: Node((i+1)*height + j),
+				})
+			}
+		}
+	}
+	g.RemoveNode(Node(height - 1))
+	g.RemoveNode(Node(columns*height + (height-1)*(columns%2)))
+	return g, nil
+}
+
+// HypercubeGraph returns the hypercube graph Q_dimension on 2^dimension nodes, in which two nodes are
+// connected when their binary representations differ in exactly one bit. It is the grid graph with
+// dimension axes of length 2, so GridCoordinates(node, [2, ..., 2]) returns the bits of the node.
+func HypercubeGraph(dimension int) (*UndirectedGraph, error) {
+	if dimension < 0 {
+		return nil, fmt.Errorf("dimension can't be < 0")
+	}
+	dimensions := make([]int, dimension)
+	for axis := range dimensions {
+		dimensions[axis] = 2
+	}
+	return GridGraph(dimensions, false)
+}
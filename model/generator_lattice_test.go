@@ -0,0 +1,197 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGridNodeAndCoordinates(t *testing.T) {
+	dimensions := []int{3, 4, 2}
+	for node := Node(0); node < 24; node++ {
+		coordinates, err := GridCoordinates(node, dimensions)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		back, err := GridNode(coordinates, dimensions)
+		if err != nil || back != node {
+			t.Errorf("Node %d maps to %v and back to %d (%v)", node, coordinates, back, err)
+		}
+	}
+	if coordinates, _ := GridCoordinates(6, []int{3, 4}); coordinates[0] != 1 || coordinates[1] != 2 {
+		t.Errorf("Expected [1 2], but got %v", coordinates)
+	}
+
+	if _, err := GridNode([]int{1}, dimensions); err == nil {
+		t.Errorf("Expected an error for missing coordinates")
+	}
+	if _, err := GridNode([]int{3, 0, 0}, dimensions); err == nil {
+		t.Errorf("Expected an error for a coordinate out of range")
+	}
+	if _, err := GridCoordinates(24, dimensions); err == nil {
+		t.Errorf("Expected an error for a node out of range")
+	}
+}
+
+func TestGridGraph(t *testing.T) {
+	testCases := []struct {
+		dimensions    []int
+		periodic      bool
+		expectedNodes int
+		expectedEdges int
+	}{
+		{dimensions: []int{3, 4}, expectedNodes: 12, expectedEdges: 17},
+		{dimensions: []int{3, 3, 3}, expectedNodes: 27, expectedEdges: 54},
+		{dimensions: []int{3, 4}, periodic: true, expectedNodes: 12, expectedEdges: 24},
+		// Axes of length 2 are not wrapped, which would duplicate their edges
+		{dimensions: []int{2, 5}, periodic: true, expectedNodes: 10, expectedEdges: 15},
+		{dimensions: []int{6}, expectedNodes: 6, expectedEdges: 5},
+		{dimensions: []int{4, 0}, expectedNodes: 0, expectedEdges: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%v,periodic=%v", tc.dimensions, tc.periodic), func(t *testing.T) {
+			g, err := GridGraph(tc.dimensions, tc.periodic)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(g.Nodes) != tc.expectedNodes || g.NumberOfEdges() != tc.expectedEdges {
+				t.Errorf("Expected %d nodes and %d edges, but got %d and %d", tc.expectedNodes, tc.expectedEdges, len(g.Nodes), g.NumberOfEdges())
+			}
+		})
+	}
+
+	// Neighbours differ by one along exactly one axis
+	dimensions := []int{3, 4, 2}
+	g, _ := GridGraph(dimensions, false)
+	for _, edge := range g.GetEdgeTuples() {
+		c1, _ := GridCoordinates(edge.Node1, dimensions)
+		c2, _ := GridCoordinates(edge.Node2, dimensions)
+		distance := 0
+		for axis := range c1 {
+			distance += max(c1[axis]-c2[axis], c2[axis]-c1[axis])
+		}
+		if distance != 1 {
+			t.Errorf("Edge %v joins coordinates %v and %v", edge, c1, c2)
+		}
+	}
+
+	if _, err := GridGraph([]int{3, -1}, false); err == nil {
+		t.Errorf("Expected an error for a negative dimension")
+	}
+}
+
+func TestGrid2DGraph(t *testing.T) {
+	g, err := Grid2DGraph(2, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	validateGraph(t, g,
+		map[Node]bool{0: true, 1: true, 2: true, 3: true, 4: true, 5: true},
+		map[Node][]Node{0: {1, 3}, 1: {0, 2, 4}, 2: {1, 5}, 3: {0, 4}, 4: {1, 3, 5}, 5: {2, 4}})
+
+	ladder, _ := Grid2DGraph(2, 5)
+	if !IsIsomorphic(ladder, LadderGraph(5), nil, nil) {
+		t.Errorf("Expected the 2x5 grid to be a ladder")
+	}
+	if _, err := Grid2DGraph(-1, 3); err == nil {
+		t.Errorf("Expected an error for negative rows")
+	}
+}
+
+func TestTorusGraph(t *testing.T) {
+	g, err := TorusGraph(3, 5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for node := range g.Nodes {
+		if g.NodeDegree(node) != 4 {
+			t.Errorf("Expected degree 4 for node %d, but got %d", node, g.NodeDegree(node))
+		}
+	}
+	if !g.HasEdge(Edge{Node1: 0, Node2: 4}) || !g.HasEdge(Edge{Node1: 0, Node2: 10}) {
+		t.Errorf("Expected the wrap-around edges 0-4 and 0-10")
+	}
+
+	// The 4x4 torus is isomorphic to the 4-dimensional hypercube
+	torus, _ := TorusGraph(4, 4)
+	hypercube, _ := HypercubeGraph(4)
+	if !IsIsomorphic(torus, hypercube, nil, nil) {
+		t.Errorf("Expected the 4x4 torus to be isomorphic to Q4")
+	}
+	if _, err := TorusGraph(2, 5); err == nil {
+		t.Errorf("Expected an error for 2 rows")
+	}
+}
+
+func TestTriangularLatticeGraph(t *testing.T) {
+	g, err := TriangularLatticeGraph(3, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(g.Nodes) != 9 || g.NumberOfEdges() != 16 || g.NodeDegree(4) != 6 {
+		t.Errorf("Unexpected triangular lattice: %d nodes, %d edges, centre degree %d", len(g.Nodes), g.NumberOfEdges(), g.NodeDegree(4))
+	}
+	// Every unit cell is split into two triangles
+	if triangles := Triangles(g); triangles[4] != 6 || triangles[0] != 2 || triangles[2] != 1 {
+		t.Errorf("Unexpected triangle counts %v", triangles)
+	}
+	if _, err := TriangularLatticeGraph(3, -2); err == nil {
+		t.Errorf("Expected an error for negative columns")
+	}
+}
+
+func TestHexagonalLatticeGraph(t *testing.T) {
+	testCases := []struct {
+		rows          int
+		columns       int
+		expectedNodes int
+		expectedEdges int
+	}{
+		{rows: 1, columns: 1, expectedNodes: 6, expectedEdges: 6},
+		{rows: 1, columns: 2, expectedNodes: 10, expectedEdges: 11},
+		{rows: 2, columns: 2, expectedNodes: 16, expectedEdges: 19},
+		{rows: 3, columns: 4, expectedNodes: 38, expectedEdges: 49},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%dx%d", tc.rows, tc.columns), func(t *testing.T) {
+			g, err := HexagonalLatticeGraph(tc.rows, tc.columns)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(g.Nodes) != tc.expectedNodes || g.NumberOfEdges() != tc.expectedEdges {
+				t.Errorf("Expected %d nodes and %d edges, but got %d and %d", tc.expectedNodes, tc.expectedEdges, len(g.Nodes), g.NumberOfEdges())
+			}
+			for node := range g.Nodes {
+				if degree := g.NodeDegree(node); degree < 2 || degree > 3 {
+					t.Errorf("Node %d has degree %d", node, degree)
+				}
+			}
+			if girth := Girth(g); girth != 6 {
+				t.Errorf("Expected girth 6, but got %d", girth)
+			}
+		})
+	}
+
+	if _, err := HexagonalLatticeGraph(0, 2); err == nil {
+		t.Errorf("Expected an error for 0 rows")
+	}
+}
+
+func TestHypercubeGraph(t *testing.T) {
+	g, err := HypercubeGraph(3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	validateGraph(t, g,
+		map[Node]bool{0: true, 1: true, 2: true, 3: true, 4: true, 5: true, 6: true, 7: true},
+		map[Node][]Node{
+			0: {1, 2, 4}, 1: {0, 3, 5}, 2: {3, 0, 6}, 3: {2, 1, 7},
+			4: {5, 6, 0}, 5: {4, 7, 1}, 6: {7, 4, 2}, 7: {6, 5, 3},
+		})
+
+	if g, _ := HypercubeGraph(0); len(g.Nodes) != 1 {
+		t.Errorf("Expected a single node, but got %v", g)
+	}
+	if _, err := HypercubeGraph(-1); err == nil {
+		t.Errorf("Expected an error for a negative dimension")
+	}
+}
@@ -0,0 +1,373 @@
+package model
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// randomSubset draws nodes from repeatedNodes uniformly at random until it holds m distinct ones, so that every
+// node is chosen with probability proportional to its number of copies. The nodes are returned in draw order.
+func randomSubset(repeatedNodes []Node, m int, random *rand.Rand) []Node {
+	chosen := make(map[Node]bool, m)
+	targets := make([]Node, 0, m)
+	for len(targets) < m {
+		node := repeatedNodes[random.Intn(len(repeatedNodes))]
+		if !chosen[node] {
+			chosen[node] = true
+			targets = append(targets, node)
+		}
+	}
+	return targets
+}
+
+// preferentialSeedGraph returns a copy of the initial graph of a preferential attachment model together with
+// every node repeated once per incident edge end. A nil initial graph stands for the star on m+1 nodes.
+func preferentialSeedGraph(initialGraph *UndirectedGraph, numberOfNodes int, m int) (*UndirectedGraph, []Node, error) {
+	if initialGraph == nil {
+		initialGraph = StarGraph(m + 1)
+	}
+	if len(initialGraph.Nodes) > numberOfNodes {
+		return nil, nil, fmt.Errorf("initial graph can't have more than %d nodes", numberOfNodes)
+	}
+	g := &UndirectedGraph{
+		Nodes: make(map[Node]bool, numberOfNodes),
+		Edges: make(map[Node][]Node, numberOfNodes),
+	}
+	var repeatedNodes []Node
+	attached := 0
+	for _, node := range sortedNodes(initialGraph.Nodes) {
+		if node < 0 || int(node) >= len(initialGraph.Nodes) {
+			return nil, nil, fmt.Errorf("initial graph nodes must be 0 .. %d, got %d", len(initialGraph.Nodes)-1, node)
+		}
+		for range initialGraph.Edges[node] {
+			repeatedNodes = append(repeatedNodes, node)
+		}
+		if len(initialGraph.Edges[node]) > 0 {
+			attached++
+		}
+	}
+	if attached < m && len(initialGraph.Nodes) < numberOfNodes {
+		return nil, nil, fmt.Errorf("initial graph needs at least %d nodes with edges", m)
+	}
+	copyInto(g, initialGraph, func(node Node) Node { return node })
+	return g, repeatedNodes, nil
+}
+
+/*
+BarabasiAlbertRandomGraph returns a random graph grown by linear preferential attachment.
+
+Parameters:
+- numberOfNodes: The number of nodes n of the graph.
+- numberOfEdges: The number of edges m attaching every new node to existing ones; 1 <= m < n.
+- initialGraph: The graph the process starts from, on nodes 0 .. k-1 with at least m nodes having edges; nil stands for the star on m+1 nodes.
+- options: Optional source of randomness, e.g. WithSeed(42) for a reproducible graph.
+
+Returns:
+- graph: The graph on nodes 0 .. n-1; nodes k .. n-1 are added in increasing order and have degree at least m.
+- error: If a parameter is out of range.
+
+Description:
+Every new node is connected to m distinct existing nodes, each chosen with
+probability proportional to its degree (Barabási and Albert). The resulting
+degree distribution follows a power law P(k) ~ k^-3, and the graph has
+|E(initialGraph)| + m(n-k) edges.
+
+Example:
+
+	g, _ := BarabasiAlbertRandomGraph(1000, 2, nil, WithSeed(42))
+	fmt.Println(g.NumberOfEdges()) // Output: 1996
+*/
+func BarabasiAlbertRandomGraph(numberOfNodes int, numberOfEdges int, initialGraph *UndirectedGraph, options ...RandomOption) (*UndirectedGraph, error) {
+	if numberOfEdges < 1 || numberOfEdges >= numberOfNodes {
+		return nil, fmt.Errorf("number of edges must be in [1, %d), got %d", numberOfNodes, numberOfEdges)
+	}
+	g, repeatedNodes, err := preferentialSeedGraph(initialGraph, numberOfNodes, numberOfEdges)
+	if err != nil {
+		return nil, err
+	}
+	random := newRandom(options...)
+	for source := Node(len(g.Nodes)); int(source) < numberOfNodes; source++ {
+		for _, target := range randomSubset(repeatedNodes, numberOfEdges, random) {
+			g.AddEdge(Edge{Node1: source, Node2: target})
+			repeatedNodes = append(repeatedNodes, target, source)
+		}
+	}
+	return g, nil
+}
+
+// DualBarabasiAlbertRandomGraph returns a preferential attachment graph in which every new node attaches with
+// m1 edges with probability p and with m2 edges otherwise (Moshiri). The initial graph plays the same role as
+// in BarabasiAlbertRandomGraph and defaults to the star on max(m1, m2)+1 nodes.
+func DualBarabasiAlbertRandomGraph(numberOfNodes int, m1 int, m2 int, probability float64, initialGraph *UndirectedGraph, options ...RandomOption) (*UndirectedGraph, error) {
+	if m1 < 1 || m1 >= numberOfNodes {
+		return nil, fmt.Errorf("m1 must be in [1, %d), got %d", numberOfNodes, m1)
+	}
+	if m2 < 1 || m2 >= numberOfNodes {
+		return nil, fmt.Errorf("m2 must be in [1, %d), got %d", numberOfNodes, m2)
+	}
+	if probability < 0 || probability > 1 {
+		return nil, fmt.Errorf("probability must be in [0, 1]")
+	}
+	g, repeatedNodes, err := preferentialSeedGraph(initialGraph, numberOfNodes, max(m1, m2))
+	if err != nil {
+		return nil, err
+	}
+	random := newRandom(options...)
+	for source := Node(len(g.Nodes)); int(source) < numberOfNodes; source++ {
+		m := m2
+		if random.Float64() < probability {
+			m = m1
+		}
+		for _, target := range randomSubset(repeatedNodes, m, random) {
+			g.AddEdge(Edge{Node1: source, Node2: target})
+			repeatedNodes = append(repeatedNodes, target, source)
+		}
+	}
+	return g, nil
+}
+
+/*
+ExtendedBarabasiAlbertRandomGraph returns a graph grown by the extended preferential attachment model of Albert and Barabási.
+
+Parameters:
+- numberOfNodes: The number of nodes n of the graph.
+- numberOfEdges: The number of edges m added, rewired or attached at every step; 1 <= m < n.
+- addProbability: The probability p of adding m edges between existing nodes.
+- rewireProbability: The probability q of rewiring m existing edges; p + q must be < 1.
+- options: Optional source of randomness, e.g. WithSeed(42) for a reproducible graph.
+
+Returns:
+- graph: A simple graph on nodes 0 .. n-1.
+- error: If a parameter is out of range.
+
+Description:
+The process starts from m isolated nodes. At every step, with probability p,
+m new edges are added, each between a uniformly random node and a node chosen
+with probability proportional to its degree plus one. With probability q, m
+edges are rewired: one end of a random edge of a random node moves to a node
+chosen with the same preference. Otherwise, a new node is attached to m
+existing nodes as in BarabasiAlbertRandomGraph. Steps that would need more
+edges than a complete graph allows, or fewer than exist, fall through to the
+attachment of a new node.
+*/
+func ExtendedBarabasiAlbertRandomGraph(numberOfNodes int, numberOfEdges int, addProbability float64, rewireProbability float64, options ...RandomOption) (*UndirectedGraph, error) {
+	m := numberOfEdges
+	if m < 1 || m >= numberOfNodes {
+		return nil, fmt.Errorf("number of edges must be in [1, %d), got %d", numberOfNodes, m)
+	}
+	if addProbability < 0 || rewireProbability < 0 || addProbability+rewireProbability >= 1 {
+		return nil, fmt.Errorf("probabilities must be >= 0 with a sum < 1")
+	}
+
+	random := newRandom(options...)
+	g := &UndirectedGraph{
+		Nodes: make(map[Node]bool, numberOfNodes),
+		Edges: make(map[Node][]Node, numberOfNodes),
+	}
+	// Every node appears once plus once per incident edge end, i.e. with weight degree + 1
+	var preference []Node
+	for i := 0; i < m; i++ {
+		g.AddNode(Node(i))
+		preference = append(preference, Node(i))
+	}
+	// preferredTarget picks a node by preference among those not excluded, which must leave at least one candidate
+	preferredTarget := func(excluded map[Node]bool) Node {
+		var candidates []Node
+		for _, node := range preference {
+			if !excluded[node] {
+				candidates = append(candidates, node)
+			}
+		}
+		return candidates[random.Intn(len(candidates))]
+	}
+	exclusion := func(node Node) map[Node]bool {
+		excluded := map[Node]bool{node: true}
+		for _, neighbor := range g.Edges[node] {
+			excluded[neighbor] = true
+		}
+		return excluded
+	}
+
+	for newNode := Node(m); int(newNode) < numberOfNodes; {
+		cliqueDegree := len(g.Nodes) - 1
+		cliqueSize := len(g.Nodes) * cliqueDegree / 2
+		edges := g.NumberOfEdges()
+		probability := random.Float64()
+		switch {
+		case probability < addProbability && edges <= cliqueSize-m:
+			var eligible []Node
+			for _, node := range sortedNodes(g.Nodes) {
+				if g.NodeDegree(node) < cliqueDegree {
+					eligible = append(eligible, node)
+				}
+			}
+			for i := 0; i < m; i++ {
+				source := eligible[random.Intn(len(eligible))]
+				target := preferredTarget(exclusion(source))
+				g.AddEdge(Edge{Node1: source, Node2: target})
+				preference = append(preference, source, target)
+				if g.NodeDegree(source) == cliqueDegree {
+					eligible = DeleteFromSlice(eligible, source)
+				}
+				if g.NodeDegree(target) == cliqueDegree {
+					eligible = DeleteFromSlice(eligible, target)
+				}
+			}
+		case probability >= addProbability && probability < addProbability+rewireProbability && m <= edges && edges < cliqueSize:
+			var eligible []Node
+			for _, node := range sortedNodes(g.Nodes) {
+				if degree := g.NodeDegree(node); degree > 0 && degree < cliqueDegree {
+					eligible = append(eligible, node)
+				}
+			}
+			for i := 0; i < m; i++ {
+				node := eligible[random.Intn(len(eligible))]
+				neighbours := g.Edges[node]
+				oldNeighbor := neighbours[random.Intn(len(neighbours))]
+				newNeighbor := preferredTarget(exclusion(node))
+				g.RemoveEdge(Edge{Node1: node, Node2: oldNeighbor})
+				g.AddEdge(Edge{Node1: node, Node2: newNeighbor})
+				for j, preferred := range preference {
+					if preferred == oldNeighbor {
+						preference = append(preference[:j], preference[j+1:]...)
+						break
+					}
+				}
+				preference = append(preference, newNeighbor)
+				if g.NodeDegree(oldNeighbor) == 0 {
+					eligible = DeleteFromSlice(eligible, oldNeighbor)
+				}
+				switch g.NodeDegree(newNeighbor) {
+				case cliqueDegree:
+					eligible = DeleteFromSlice(eligible, newNeighbor)
+				case 1:
+					eligible = append(eligible, newNeighbor)
+				}
+			}
+		default:
+			targets := randomSubset(preference, m, random)
+			for _, target := range targets {
+				g.AddEdge(Edge{Node1: newNode, Node2: target})
+			}
+			preference = append(preference, targets...)
+			for i := 0; i <= m; i++ {
+				preference = append(preference, newNode)
+			}
+			newNode++
+		}
+	}
+	return g, nil
+}
+
+// PowerlawClusterRandomGraph returns a graph of the Holme and Kim model: preferential attachment with a
+// triad formation step, which gives a power law degree distribution with a tunable clustering coefficient.
+// Starting from m isolated nodes, every new node makes a first preferential attachment; each of its m-1 other
+// edges goes, with probability p, to a random neighbour of the most recent preferential target it is not yet
+// connected to (closing a triangle), and otherwise to another node chosen by preferential attachment, which
+// then becomes the most recent preferential target.
+func PowerlawClusterRandomGraph(numberOfNodes int, numberOfEdges int, triangleProbability float64, options ...RandomOption) (*UndirectedGraph, error) {
+	m := numberOfEdges
+	if m < 1 || m >= numberOfNodes {
+		return nil, fmt.Errorf("number of edges must be in [1, %d), got %d", numberOfNodes, m)
+	}
+	if triangleProbability < 0 || triangleProbability > 1 {
+		return nil, fmt.Errorf("probability must be in [0, 1]")
+	}
+
+	random := newRandom(options...)
+	g := &UndirectedGraph{
+		Nodes: make(map[Node]bool, numberOfNodes),
+		Edges: make(map[Node][]Node, numberOfNodes),
+	}
+	var repeatedNodes []Node
+	for i := 0; i < m; i++ {
+		g.AddNode(Node(i))
+		repeatedNodes = append(repeatedNodes, Node(i))
+	}
+	for source := Node(m); int(source) < numberOfNodes; source++ {
+		targets := randomSubset(repeatedNodes, m, random)
+		target := targets[len(targets)-1]
+		targets = targets[:len(targets)-1]
+		g.AddEdge(Edge{Node1: source, Node2: target})
+		repeatedNodes = append(repeatedNodes, target)
+		for count := 1; count < m; count++ {
+			if random.Float64() < triangleProbability {
+				var neighbourhood []Node
+				for _, neighbor := range g.Edges[target] {
+					if neighbor != source && !g.HasEdge(Edge{Node1: source, Node2: neighbor}) {
+						neighbourhood = append(neighbourhood, neighbor)
+					}
+				}
+				if len(neighbourhood) > 0 {
+					neighbor := neighbourhood[random.Intn(len(neighbourhood))]
+					g.AddEdge(Edge{Node1: source, Node2: neighbor})
+					repeatedNodes = append(repeatedNodes, neighbor)
+					continue
+				}
+			}
+			// The preferential targets are distinct, but a triad step may already have used one of them
+			for g.HasEdge(Edge{Node1: source, Node2: targets[len(targets)-1]}) {
+				targets = targets[:len(targets)-1]
+			}
+			target = targets[len(targets)-1]
+			targets = targets[:len(targets)-1]
+			g.AddEdge(Edge{Node1: source, Node2: target})
+			repeatedNodes = append(repeatedNodes, target)
+		}
+		for i := 0; i < m; i++ {
+			repeatedNodes = append(repeatedNodes, source)
+		}
+	}
+	return g, nil
+}
+
+/*
+LinearizedChordDiagramGraph returns a random multigraph of the linearized chord diagram (LCD) model of Bollobás and Riordan.
+
+Parameters:
+- numberOfNodes: The number of nodes n.
+- numberOfEdges: The number of edges m of every node; m >= 1.
+- options: Optional source of randomness, e.g. WithSeed(42) for a reproducible graph.
+
+Returns:
+- graph: A multigraph on nodes 0 .. n-1 with exactly n*m edges, which may contain parallel edges and self-loops.
+- error: If a parameter is out of range.
+
+Description:
+The LCD model is the precise version of the Barabási-Albert process used to
+prove its degree distribution. Edges are added one at a time to a graph on
+n*m vertices: vertex t gets a single edge whose other end is an earlier
+vertex chosen with probability proportional to its degree, or t itself with
+probability 1/(2t+1). The vertices m*i .. m*i+m-1 are then merged into node i.
+*/
+func LinearizedChordDiagramGraph(numberOfNodes int, numberOfEdges int, options ...RandomOption) (*UndirectedGraph, error) {
+	if numberOfNodes < 0 {
+		return nil, fmt.Errorf("number of nodes can't be < 0")
+	}
+	if numberOfEdges < 1 {
+		return nil, fmt.Errorf("number of edges can't be < 1")
+	}
+
+	random := newRandom(options...)
+	g := &UndirectedGraph{
+		Nodes: make(map[Node]bool, numberOfNodes),
+		Edges: make(map[Node][]Node, numberOfNodes),
+	}
+	// endpoints lists both ends of every edge, so a uniform pick is a pick proportional to degree
+	endpoints := make([]int, 0, 2*numberOfNodes*numberOfEdges)
+	for vertex := 0; vertex < numberOfNodes*numberOfEdges; vertex++ {
+		target := vertex
+		if r := random.Intn(len(endpoints) + 1); r < len(endpoints) {
+			target = endpoints[r]
+		}
+		endpoints = append(endpoints, vertex, target)
+		g.AddEdge(Edge{
+			Node1: Node(vertex / numberOfEdges),
+			Node2: Node(target / numberOfEdges),
+		})
+	}
+	for i := 0; i < numberOfNodes; i++ {
+		g.AddNode(Node(i))
+	}
+	return g, nil
+}
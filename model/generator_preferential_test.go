@@ -0,0 +1,202 @@
+package model
+
+import "testing"
+
+func TestBarabasiAlbertRandomGraph(t *testing.T) {
+	tests := []struct {
+		name         string
+		m            int
+		initialGraph *UndirectedGraph
+		initialEdges int
+	}{
+		{"DefaultStar", 1, nil, 1},
+		{"DefaultStarM3", 3, nil, 3},
+		{"Complete", 3, CompleteGraph(5), 10},
+		{"Cycle", 2, CycleGraph(4), 4},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			initialNodes := test.m + 1
+			if test.initialGraph != nil {
+				initialNodes = len(test.initialGraph.Nodes)
+			}
+			g, err := BarabasiAlbertRandomGraph(200, test.m, test.initialGraph, WithSeed(1))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			expectedEdges := test.initialEdges + test.m*(200-initialNodes)
+			if len(g.Nodes) != 200 || g.NumberOfEdges() != expectedEdges {
+				t.Errorf("Expected 200 nodes and %d edges, but got %d and %d", expectedEdges, len(g.Nodes), g.NumberOfEdges())
+			}
+			if !isSimpleGraph(g) {
+				t.Errorf("Expected a simple graph")
+			}
+			for node := Node(initialNodes); node < 200; node++ {
+				if g.NodeDegree(node) < test.m {
+					t.Errorf("Expected node %d to have degree >= %d, but got %d", node, test.m, g.NodeDegree(node))
+				}
+			}
+			if len(ConnectedComponents(g).ComponentsArray) != 1 {
+				t.Errorf("Expected a connected graph")
+			}
+		})
+	}
+
+	for _, parameters := range [][2]int{{10, 0}, {10, 10}, {3, 5}} {
+		if _, err := BarabasiAlbertRandomGraph(parameters[0], parameters[1], nil); err == nil {
+			t.Errorf("Expected an error for n = %d and m = %d", parameters[0], parameters[1])
+		}
+	}
+	if _, err := BarabasiAlbertRandomGraph(10, 3, PathGraph(2)); err == nil {
+		t.Errorf("Expected an error for an initial graph with too few nodes")
+	}
+	if _, err := BarabasiAlbertRandomGraph(3, 1, PathGraph(5)); err == nil {
+		t.Errorf("Expected an error for an initial graph with too many nodes")
+	}
+}
+
+func TestBarabasiAlbertPreferentialAttachment(t *testing.T) {
+	// Under uniform attachment, the oldest nodes of a graph on 2000 nodes have a degree
+	// of about m log(n) ≈ 15; preferential attachment makes them hubs of degree ≈ m sqrt(n) ≈ 90
+	g, _ := BarabasiAlbertRandomGraph(2000, 2, nil, WithSeed(7))
+	maxDegree := 0
+	for node := range g.Nodes {
+		maxDegree = max(maxDegree, g.NodeDegree(node))
+	}
+	if maxDegree < 40 {
+		t.Errorf("Expected a hub of degree >= 40, but the maximum degree is %d", maxDegree)
+	}
+
+	// Node 3 attaches to the middle of the path 0 - 1 - 2 with probability 2/4
+	hits, trials := 0, 4000
+	for i := 0; i < trials; i++ {
+		g, _ := BarabasiAlbertRandomGraph(4, 1, PathGraph(3), WithSeed(int64(i)))
+		if g.HasEdge(Edge{Node1: 3, Node2: 1}) {
+			hits++
+		}
+	}
+	if frequency := float64(hits) / float64(trials); frequency < 0.45 || frequency > 0.55 {
+		t.Errorf("Expected node 1 to be chosen with probability 1/2, but got %v", frequency)
+	}
+}
+
+func TestDualBarabasiAlbertRandomGraph(t *testing.T) {
+	g, err := DualBarabasiAlbertRandomGraph(300, 1, 3, 0.5, nil, WithSeed(2))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The default star on 4 nodes has 3 edges, and every other node adds 1 or 3 edges
+	if edges := g.NumberOfEdges(); edges <= 3+296 || edges >= 3+3*296 {
+		t.Errorf("Expected between %d and %d edges, but got %d", 3+296, 3+3*296, edges)
+	}
+	if len(g.Nodes) != 300 || !isSimpleGraph(g) {
+		t.Errorf("Expected a simple graph on 300 nodes, but got %d nodes", len(g.Nodes))
+	}
+
+	// With probability 1 the model is the Barabási-Albert model with m1 edges
+	dual, _ := DualBarabasiAlbertRandomGraph(100, 2, 4, 1, CompleteGraph(4), WithSeed(3))
+	if dual.NumberOfEdges() != 6+2*96 {
+		t.Errorf("Expected %d edges, but got %d", 6+2*96, dual.NumberOfEdges())
+	}
+
+	if _, err := DualBarabasiAlbertRandomGraph(10, 1, 2, 1.5, nil); err == nil {
+		t.Errorf("Expected an error for a probability > 1")
+	}
+	if _, err := DualBarabasiAlbertRandomGraph(10, 0, 2, 0.5, nil); err == nil {
+		t.Errorf("Expected an error for m1 = 0")
+	}
+}
+
+func TestExtendedBarabasiAlbertRandomGraph(t *testing.T) {
+	// Without edge additions and rewiring every new node adds exactly m edges
+	g, err := ExtendedBarabasiAlbertRandomGraph(100, 3, 0, 0, WithSeed(4))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(g.Nodes) != 100 || g.NumberOfEdges() != 3*97 {
+		t.Errorf("Expected 100 nodes and %d edges, but got %d and %d", 3*97, len(g.Nodes), g.NumberOfEdges())
+	}
+
+	for _, probabilities := range [][2]float64{{0.3, 0.3}, {0.6, 0}, {0, 0.6}} {
+		g, err := ExtendedBarabasiAlbertRandomGraph(200, 2, probabilities[0], probabilities[1], WithSeed(5))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(g.Nodes) != 200 || !isSimpleGraph(g) {
+			t.Errorf("Expected a simple graph on 200 nodes for p, q = %v", probabilities)
+		}
+		// Additions never remove edges and rewiring keeps their number
+		if g.NumberOfEdges() < 2*198 {
+			t.Errorf("Expected at least %d edges for p, q = %v, but got %d", 2*198, probabilities, g.NumberOfEdges())
+		}
+	}
+
+	if _, err := ExtendedBarabasiAlbertRandomGraph(10, 2, 0.5, 0.5); err == nil {
+		t.Errorf("Expected an error for p + q = 1")
+	}
+	if _, err := ExtendedBarabasiAlbertRandomGraph(10, 10, 0.1, 0.1); err == nil {
+		t.Errorf("Expected an error for m = n")
+	}
+}
+
+func TestPowerlawClusterRandomGraph(t *testing.T) {
+	triangles := func(g *UndirectedGraph) int {
+		total := 0
+		for _, count := range Triangles(g) {
+			total += count
+		}
+		return total / 3
+	}
+	var counts [2]int
+	for i, probability := range []float64{0, 1} {
+		g, err := PowerlawClusterRandomGraph(500, 3, probability, WithSeed(6))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(g.Nodes) != 500 || g.NumberOfEdges() != 3*497 || !isSimpleGraph(g) {
+			t.Errorf("Expected a simple graph with 500 nodes and %d edges, but got %d and %d", 3*497, len(g.Nodes), g.NumberOfEdges())
+		}
+		counts[i] = triangles(g)
+	}
+	// Each triad formation step closes a triangle
+	if counts[1] < 3*counts[0] {
+		t.Errorf("Expected triad formation to add triangles, but got %d triangles without and %d with", counts[0], counts[1])
+	}
+
+	if _, err := PowerlawClusterRandomGraph(10, 2, -0.1); err == nil {
+		t.Errorf("Expected an error for a negative probability")
+	}
+}
+
+func TestLinearizedChordDiagramGraph(t *testing.T) {
+	g, err := LinearizedChordDiagramGraph(300, 2, WithSeed(8))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(g.Nodes) != 300 || g.NumberOfEdges() != 600 {
+		t.Errorf("Expected 300 nodes and 600 edges, but got %d and %d", len(g.Nodes), g.NumberOfEdges())
+	}
+	for node := range g.Nodes {
+		if g.NodeDegree(node) < 2 {
+			t.Errorf("Expected node %d to have degree >= 2, but got %d", node, g.NodeDegree(node))
+		}
+	}
+	// Node 0 starts with a self-loop, since the first edge has nowhere else to go
+	if !hasSelfLoop(g, 0) {
+		t.Errorf("Expected a self-loop at node 0")
+	}
+
+	if _, err := LinearizedChordDiagramGraph(10, 0); err == nil {
+		t.Errorf("Expected an error for m = 0")
+	}
+}
+
+func TestPreferentialAttachmentIsReproducible(t *testing.T) {
+	g1, _ := BarabasiAlbertRandomGraph(100, 2, nil, WithSeed(9))
+	g2, _ := BarabasiAlbertRandomGraph(100, 2, nil, WithSeed(9))
+	e1, _ := ExtendedBarabasiAlbertRandomGraph(100, 2, 0.2, 0.2, WithSeed(9))
+	e2, _ := ExtendedBarabasiAlbertRandomGraph(100, 2, 0.2, 0.2, WithSeed(9))
+	if !g1.Equals(g2) || !e1.Equals(e2) {
+		t.Errorf("Expected the same graphs for the same seed")
+	}
+}
@@ -1,8 +1,8 @@
 package model
 
 import (
+	"fmt"
 	"math"
-	"math/rand"
 )
 
 // FastGNPRandomGraph generates a random undirected graph using the G(n,p) model,
@@ -14,6 +14,7 @@ import (
 //   - numberOfNodes: The total number of nodes in the graph.
 //   - probabilityForEdgeCreation: The probability of creating an edge between any two nodes,
 //     ranging from 0.0 (no edges) to 1.0 (fully connected graph).
+//   - options: Optional source of randomness, e.g. WithSeed(42) for a reproducible graph.
 //
 // Returns:
 //
@@ -28,7 +29,8 @@ import (
 //
 // Returns a $G_{n,p}$ random graph, also known as an Erdős-Rényi graph or a binomial graph.
 // References: [1] Vladimir Batagelj and Ulrik Brandes, "Efficient generation of large random networks", Phys. Rev. E, 71, 036113, 2005.
-func FastGNPRandomGraph(numberOfNodes int, probabilityForEdgeCreation float64) (g UndirectedGraph) {
+func FastGNPRandomGraph(numberOfNodes int, probabilityForEdgeCreation float64, options ...RandomOption) (g UndirectedGraph) {
+	random := newRandom(options...)
 	g = UndirectedGraph{}
 	g.Edges = make(map[Node][]Node)
 	g.Nodes = make(map[Node]bool, numberOfNodes)
@@ -37,7 +39,7 @@ func FastGNPRandomGraph(numberOfNodes int, probabilityForEdgeCreation float64) (
 	v := 1
 	w := -1
 	for v < numberOfNodes {
-		lr := math.Log(1.0 - rand.Float64())
+		lr := math.Log(1.0 - random.Float64())
 		w = w + 1 + int(lr/lp)
 		for w >= v && v < numberOfNodes {
 			w = w - v
@@ -54,23 +56,33 @@ func FastGNPRandomGraph(numberOfNodes int, probabilityForEdgeCreation float64) (
 // of all graphs with $n$ nodes and $m$ edges.
 // Algorithm by Keith M. Briggs Mar 31, 2006.
 // Inspired by Knuth's Algorithm S (Selection sampling technique),
-// in section 3.4.2 of [1]
+// in section 3.4.2 of [1]: the n(n-1)/2 node pairs are visited in order and
+// the pair t is selected with probability (m - k) / (n(n-1)/2 - t), where k
+// pairs are already selected. This takes O(n^2) time, so GNMRandomGraph is
+// faster for sparse graphs.
 // References: [1] Donald E. Knuth, The Art of Computer Programming,
 // Volume 2/Seminumerical algorithms, Third Edition, Addison-Wesley, 1997.
-func DenseGNMRandomGraph(numberOfNodes int, numberOfEdges int) (g *UndirectedGraph) {
-	edgesMax := numberOfNodes * (numberOfNodes - 1) // 2
+func DenseGNMRandomGraph(numberOfNodes int, numberOfEdges int, options ...RandomOption) (g *UndirectedGraph) {
+	random := newRandom(options...)
+	edgesMax := numberOfNodes * (numberOfNodes - 1) / 2
+	g = &UndirectedGraph{
+		Nodes: make(map[Node]bool, max(numberOfNodes, 0)),
+		Edges: make(map[Node][]Node, max(numberOfNodes, 0)),
+	}
+	for i := 0; i < numberOfNodes; i++ {
+		g.AddNode(Node(i))
+	}
 	if numberOfEdges >= edgesMax {
-		return CompleteGraph(numberOfNodes)
-	} else {
-		g = &UndirectedGraph{}
+		copyInto(g, CompleteGraph(numberOfNodes), func(node Node) Node { return node })
+		return g
 	}
-	if numberOfNodes == 1 || numberOfEdges >= edgesMax {
+	if numberOfEdges <= 0 {
 		return g
 	}
 
-	u, v, t, k := 0, 0, 0, 0
+	u, v, t, k := 0, 1, 0, 0
 	for {
-		if (t + rand.Int()*(edgesMax-t)) < (numberOfEdges - k) {
+		if random.Intn(edgesMax-t) < numberOfEdges-k {
 			g.AddEdge(Edge{Node(u), Node(v)})
 			k = k + 1
 			if k == numberOfEdges {
@@ -86,23 +98,148 @@ func DenseGNMRandomGraph(numberOfNodes int, numberOfEdges int) (g *UndirectedGra
 	}
 }
 
-func BarabasiAlbertRandomGraph(numberOfNodes int, numberOfEdges int) (g *UndirectedGraph) {
-	g = &UndirectedGraph{}
-	// generate a Barabasi-Albert graph
-	for i := numberOfEdges / 2; i < numberOfNodes; i++ {
-		for j := 0; j < numberOfEdges; j++ {
-			neighbor := (i + j - numberOfEdges/2) % numberOfNodes
-			g.AddEdge(Edge{
-				Node1: Node(i),
-				Node2: Node(neighbor),
-			})
+/*
+GNMRandomGraph returns a G(n,m) random graph, chosen uniformly among the graphs with n nodes and m edges.
+
+Parameters:
+- numberOfNodes: The number of nodes n.
+- numberOfEdges: The number of edges m; the complete graph is returned if m >= n(n-1)/2.
+- options: Optional source of randomness, e.g. WithSeed(42) for a reproducible graph.
+
+Returns:
+- graph: The graph on nodes 0 .. n-1.
+- error: If n or m is negative.
+
+Description:
+Edges are drawn as uniformly random node pairs, rejecting self-loops and
+pairs already drawn, so the expected running time is O(n + m) as long as m is
+at most half of the possible edges; this scales to millions of nodes, where
+DenseGNMRandomGraph would need O(n^2) time. Denser graphs are obtained by
+drawing the n(n-1)/2 - m missing edges instead.
+
+Example:
+
+	g, _ := GNMRandomGraph(1000000, 3000000, WithSeed(42))
+	fmt.Println(g.NumberOfEdges()) // Output: 3000000
+*/
+func GNMRandomGraph(numberOfNodes int, numberOfEdges int, options ...RandomOption) (*UndirectedGraph, error) {
+	if numberOfNodes < 0 {
+		return nil, fmt.Errorf("number of nodes can't be < 0")
+	}
+	if numberOfEdges < 0 {
+		return nil, fmt.Errorf("number of edges can't be < 0")
+	}
+
+	random := newRandom(options...)
+	g := &UndirectedGraph{
+		Nodes: make(map[Node]bool, numberOfNodes),
+		Edges: make(map[Node][]Node, numberOfNodes),
+	}
+	for i := 0; i < numberOfNodes; i++ {
+		g.AddNode(Node(i))
+	}
+	edgesMax := numberOfNodes * (numberOfNodes - 1) / 2
+	if numberOfEdges >= edgesMax {
+		copyInto(g, CompleteGraph(numberOfNodes), func(node Node) Node { return node })
+		return g, nil
+	}
+
+	// Draw whichever of the edges and the missing edges are fewer
+	complement := numberOfEdges > edgesMax/2
+	draws := numberOfEdges
+	if complement {
+		draws = edgesMax - numberOfEdges
+	}
+	drawn := make(map[Edge]bool, draws)
+	var edges []Edge
+	for len(edges) < draws {
+		u, v := Node(random.Intn(numberOfNodes)), Node(random.Intn(numberOfNodes))
+		edge := Edge{Node1: min(u, v), Node2: max(u, v)}
+		if u == v || drawn[edge] {
+			continue
 		}
+		drawn[edge] = true
+		edges = append(edges, edge)
 	}
 
-	return g
+	if complement {
+		edges = edges[:0]
+		for u := 0; u < numberOfNodes; u++ {
+			for v := u + 1; v < numberOfNodes; v++ {
+				if edge := (Edge{Node1: Node(u), Node2: Node(v)}); !drawn[edge] {
+					edges = append(edges, edge)
+				}
+			}
+		}
+	}
+	for _, edge := range edges {
+		g.AddEdge(edge)
+	}
+	return g, nil
+}
+
+/*
+DirectedGNPRandomGraph returns a directed G(n,p) random graph, in which each of the n(n-1) ordered pairs of distinct nodes is an edge independently with probability p.
+
+Parameters:
+- numberOfNodes: The number of nodes n.
+- probabilityForEdgeCreation: The probability p of every directed edge.
+- options: Optional source of randomness, e.g. WithSeed(42) for a reproducible graph.
+
+Returns:
+- graph: The directed graph on nodes 0 .. n-1, without self-loops.
+- error: If n is negative or p is not in [0, 1].
+
+Description:
+Like FastGNPRandomGraph, the function jumps directly from one edge to the next
+with geometrically distributed gaps (Batagelj and Brandes), so it takes
+O(n + m) time for m edges.
+*/
+func DirectedGNPRandomGraph(numberOfNodes int, probabilityForEdgeCreation float64, options ...RandomOption) (*DirectedGraph, error) {
+	if numberOfNodes < 0 {
+		return nil, fmt.Errorf("number of nodes can't be < 0")
+	}
+	if probabilityForEdgeCreation < 0 || probabilityForEdgeCreation > 1 {
+		return nil, fmt.Errorf("probability must be in [0, 1]")
+	}
+
+	random := newRandom(options...)
+	g := &DirectedGraph{
+		Nodes: make(map[Node]bool, numberOfNodes),
+		Edges: make(map[Node][]Node, numberOfNodes),
+	}
+	for i := 0; i < numberOfNodes; i++ {
+		g.AddNode(Node(i))
+	}
+	if probabilityForEdgeCreation == 0 {
+		return g, nil
+	}
+
+	lp := math.Log(1.0 - probabilityForEdgeCreation)
+	// The pairs w < v are visited as in FastGNPRandomGraph, once for the edges w -> v and once for v -> w
+	for _, forward := range []bool{true, false} {
+		v, w := 1, -1
+		for v < numberOfNodes {
+			lr := math.Log(1.0 - random.Float64())
+			w = w + 1 + int(lr/lp)
+			for w >= v && v < numberOfNodes {
+				w = w - v
+				v = v + 1
+			}
+			if v < numberOfNodes {
+				if forward {
+					g.AddEdge(Edge{Node1: Node(w), Node2: Node(v)})
+				} else {
+					g.AddEdge(Edge{Node1: Node(v), Node2: Node(w)})
+				}
+			}
+		}
+	}
+	return g, nil
 }
 
-func WattsStrogatzRandomGraph(numberOfNodes int, nearestNeighboursCount int, edgeRewiringProbability float32) (g *UndirectedGraph) {
+func WattsStrogatzRandomGraph(numberOfNodes int, nearestNeighboursCount int, edgeRewiringProbability float32, options ...RandomOption) (g *UndirectedGraph) {
+	random := newRandom(options...)
 	g = &UndirectedGraph{}
 	// generate a Watts Strogatz graph
 	g.Nodes = make(map[Node]bool)
@@ -127,9 +264,9 @@ func WattsStrogatzRandomGraph(numberOfNodes int, nearestNeighboursCount int, edg
 	// rewire edges with probability
 	for i := 0; i < numberOfNodes; i++ {
 		for j := 1; j <= nearestNeighboursCount/2; j++ {
-			if rand.Float32() < edgeRewiringProbability {
+			if random.Float32() < edgeRewiringProbability {
 				neighbor := (i + j) % numberOfNodes
-				newNeighbor := Node(rand.Intn(numberOfNodes))
+				newNeighbor := Node(random.Intn(numberOfNodes))
 				g.RemoveEdge(Edge{
 					Node1: Node(i),
 					Node2: Node(neighbor),
@@ -0,0 +1,145 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+)
+
+// edgeKey returns the sorted edge list of an undirected graph, identifying it among the graphs on the same nodes.
+func edgeKey(g *UndirectedGraph) string {
+	var edges []Edge
+	for _, edge := range g.GetEdgeTuples() {
+		if edge.Node1 < edge.Node2 {
+			edges = append(edges, edge)
+		}
+	}
+	sortEdges(edges)
+	return fmt.Sprint(edges)
+}
+
+// chiSquare returns the chi-square statistic of the observed counts against a uniform distribution over categories.
+func chiSquare(counts map[string]int, categories int, samples int) float64 {
+	expected := float64(samples) / float64(categories)
+	statistic := float64(categories-len(counts)) * expected
+	for _, count := range counts {
+		statistic += (float64(count) - expected) * (float64(count) - expected) / expected
+	}
+	return statistic
+}
+
+func TestGNMRandomGraphsAreUniform(t *testing.T) {
+	// There are C(6, 2) = 15 graphs with 4 nodes and 2 edges, and C(6, 4) = 15 with 4 edges. With 14
+	// degrees of freedom, the chi-square statistic exceeds 36.1 with probability 0.001.
+	generators := map[string]func(m int, seed int64) *UndirectedGraph{
+		"Dense": func(m int, seed int64) *UndirectedGraph {
+			return DenseGNMRandomGraph(4, m, WithSeed(seed))
+		},
+		"Sparse": func(m int, seed int64) *UndirectedGraph {
+			g, _ := GNMRandomGraph(4, m, WithSeed(seed))
+			return g
+		},
+	}
+	samples := 6000
+	for name, generate := range generators {
+		for _, m := range []int{2, 4} {
+			t.Run(fmt.Sprintf("%s/m=%d", name, m), func(t *testing.T) {
+				counts := make(map[string]int)
+				for i := 0; i < samples; i++ {
+					g := generate(m, int64(i))
+					if len(g.Nodes) != 4 || g.NumberOfEdges() != m || !isSimpleGraph(g) {
+						t.Fatalf("Expected a simple graph with 4 nodes and %d edges, but got %v", m, g)
+					}
+					counts[edgeKey(g)]++
+				}
+				if len(counts) != 15 {
+					t.Errorf("Expected all 15 graphs, but got %d", len(counts))
+				}
+				if statistic := chiSquare(counts, 15, samples); statistic > 36.1 {
+					t.Errorf("Expected a uniform distribution, but the chi-square statistic is %v", statistic)
+				}
+			})
+		}
+	}
+}
+
+func TestGNMRandomGraph(t *testing.T) {
+	tests := []struct {
+		name  string
+		nodes int
+		edges int
+	}{
+		{"Empty", 10, 0},
+		{"Sparse", 1000, 3000},
+		{"Dense", 30, 400},
+		{"Complete", 6, 15},
+		{"MoreThanComplete", 6, 20},
+		{"NoNodes", 0, 5},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expectedEdges := min(test.edges, test.nodes*(test.nodes-1)/2)
+			g, err := GNMRandomGraph(test.nodes, test.edges, WithSeed(1))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			dense := DenseGNMRandomGraph(test.nodes, test.edges, WithSeed(1))
+			for _, graph := range []*UndirectedGraph{g, dense} {
+				if len(graph.Nodes) != test.nodes || graph.NumberOfEdges() != expectedEdges || !isSimpleGraph(graph) {
+					t.Errorf("Expected a simple graph with %d nodes and %d edges, but got %d and %d", test.nodes, expectedEdges, len(graph.Nodes), graph.NumberOfEdges())
+				}
+			}
+		})
+	}
+
+	if _, err := GNMRandomGraph(-1, 2); err == nil {
+		t.Errorf("Expected an error for a negative number of nodes")
+	}
+	if _, err := GNMRandomGraph(5, -2); err == nil {
+		t.Errorf("Expected an error for a negative number of edges")
+	}
+}
+
+func TestDirectedGNPRandomGraph(t *testing.T) {
+	// Every ordered pair is an edge with probability p; over 2000 graphs on 5 nodes, the frequency of each of
+	// the 20 pairs has a standard deviation of sqrt(0.3 * 0.7 / 2000) ≈ 0.01
+	samples, p := 2000, 0.3
+	counts := make(map[Edge]int)
+	for i := 0; i < samples; i++ {
+		g, err := DirectedGNPRandomGraph(5, p, WithSeed(int64(i)))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(g.Nodes) != 5 {
+			t.Fatalf("Expected 5 nodes, but got %d", len(g.Nodes))
+		}
+		for _, edge := range g.GetEdgeTuples() {
+			if edge.Node1 == edge.Node2 || counts[edge] > i {
+				t.Fatalf("Unexpected self-loop or parallel edge %v", edge)
+			}
+			counts[edge]++
+		}
+	}
+	if len(counts) != 20 {
+		t.Errorf("Expected all 20 ordered pairs, but got %d", len(counts))
+	}
+	for edge, count := range counts {
+		if frequency := float64(count) / float64(samples); frequency < p-0.05 || frequency > p+0.05 {
+			t.Errorf("Expected edge %v with frequency %v, but got %v", edge, p, frequency)
+		}
+	}
+
+	// Large sparse graphs have about n(n-1)p edges
+	g, _ := DirectedGNPRandomGraph(2000, 0.001, WithSeed(1))
+	if edges := g.NumberOfEdges(); edges < 3700 || edges > 4300 {
+		t.Errorf("Expected about 4000 edges, but got %d", edges)
+	}
+	if complete, _ := DirectedGNPRandomGraph(6, 1); complete.NumberOfEdges() != 30 {
+		t.Errorf("Expected 30 edges for p = 1, but got %d", complete.NumberOfEdges())
+	}
+	if empty, _ := DirectedGNPRandomGraph(6, 0); empty.NumberOfEdges() != 0 || len(empty.Nodes) != 6 {
+		t.Errorf("Expected 6 isolated nodes for p = 0, but got %v", empty)
+	}
+	if _, err := DirectedGNPRandomGraph(5, 1.2); err == nil {
+		t.Errorf("Expected an error for a probability > 1")
+	}
+}
@@ -0,0 +1,128 @@
+package model
+
+import "fmt"
+
+// LCFGraph returns the cubic Hamiltonian graph described by the LCF notation [shifts]^repeats.
+// The nodes 0 .. n-1 form a cycle and, for i in 0 .. len(shifts)*repeats-1, node i mod n is connected to
+// node (i + shifts[i mod len(shifts)]) mod n. Chords listed from both endpoints are only added once.
+//
+// Example:
+//
+//	// The Heawood graph is [5, -5]^7
+//	graph, _ := LCFGraph(14, []int{5, -5}, 7)
+func LCFGraph(numberOfNodes int, shifts []int, repeats int) (*UndirectedGraph, error) {
+	if numberOfNodes < 0 {
+		return nil, fmt.Errorf("number of nodes can't be < 0")
+	}
+	if repeats < 0 {
+		return nil, fmt.Errorf("repeats can't be < 0")
+	}
+	g := CycleGraph(numberOfNodes)
+	if numberOfNodes == 0 {
+		return g, nil
+	}
+	for i := 0; i < len(shifts)*repeats; i++ {
+		node := i % numberOfNodes
+		neighbor := ((node+shifts[i%len(shifts)])%numberOfNodes + numberOfNodes) % numberOfNodes
+		edge := Edge{Node1: Node(node), Node2: Node(neighbor)}
+		if node != neighbor && !g.HasEdge(edge) {
+			g.AddEdge(edge)
+		}
+	}
+	return g, nil
+}
+
+// GeneralizedPetersenGraph returns the generalized Petersen graph GP(n, k) on 2n nodes.
+// The outer nodes 0 .. n-1 form a cycle, every outer node i is connected to the inner node n+i, and the
+// inner node n+i is connected to n+(i+k) mod n. The parameters must satisfy n >= 3 and 1 <= k < n/2.
+func GeneralizedPetersenGraph(n int, k int) (*UndirectedGraph, error) {
+	if n < 3 {
+		return nil, fmt.Errorf("n can't be < 3")
+	}
+	if k < 1 || 2*k >= n {
+		return nil, fmt.Errorf("k must be in [1, n/2)")
+	}
+	g := CycleGraph(n)
+	for i := 0; i < n; i++ {
+		g.AddEdge(Edge{
+			Node1: Node(i),
+			Node2: Node(n + i),
+		})
+		g.AddEdge(Edge{
+			Node1: Node(n + i),
+			Node2: Node(n + (i+k)%n),
+		})
+	}
+	return g, nil
+}
+
+// PetersenGraph returns the Petersen graph GP(5, 2): 10 nodes, 15 edges, cubic, girth 5 and not Hamiltonian.
+func PetersenGraph() *UndirectedGraph {
+	g, _ := GeneralizedPetersenGraph(5, 2)
+	return g
+}
+
+// HeawoodGraph returns the Heawood graph [5, -5]^7: the bipartite cubic cage of girth 6 on 14 nodes and 21 edges.
+func HeawoodGraph() *UndirectedGraph {
+	g, _ := LCFGraph(14, []int{5, -5}, 7)
+	return g
+}
+
+// DodecahedralGraph returns the skeleton of the dodecahedron, [10, 7, 4, -4, -7, 10, -4, 7, -7, 4]^2:
+// 20 nodes, 30 edges, cubic and planar with girth 5.
+func DodecahedralGraph() *UndirectedGraph {
+	g, _ := LCFGraph(20, []int{10, 7, 4, -4, -7, 10, -4, 7, -7, 4}, 2)
+	return g
+}
+
+// CubicalGraph returns the skeleton of the cube, which is the hypercube Q3 on 8 nodes and 12 edges.
+func CubicalGraph() *UndirectedGraph {
+	g, _ := HypercubeGraph(3)
+	return g
+}
+
+// OctahedralGraph returns the skeleton of the octahedron, which is the complete multipartite graph K_{2,2,2}
+// on 6 nodes and 12 edges; opposite vertices 2i and 2i+1 are the only non-adjacent pairs.
+func OctahedralGraph() *UndirectedGraph {
+	g, _ := CompleteMultipartiteGraph(2, 2, 2)
+	return g
+}
+
+// FruchtGraph returns the Frucht graph: a cubic graph on 12 nodes and 18 edges whose only automorphism is the identity.
+func FruchtGraph() *UndirectedGraph {
+	g := CycleGraph(7)
+	g.AddEdgesFromIntTupleList([][2]int{
+		{0, 7}, {1, 7}, {2, 8}, {3, 9}, {4, 9}, {5, 10}, {6, 10}, {7, 11}, {8, 11}, {8, 9}, {10, 11},
+	})
+	return g
+}
+
+// ChvatalGraph returns the Chvátal graph: the smallest triangle-free 4-regular graph with chromatic number 4,
+// on 12 nodes and 24 edges.
+func ChvatalGraph() *UndirectedGraph {
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{
+		{0, 1}, {0, 4}, {0, 6}, {0, 9}, {1, 2}, {1, 5}, {1, 7}, {2, 3}, {2, 6}, {2, 8},
+		{3, 4}, {3, 7}, {3, 9}, {4, 5}, {4, 8}, {5, 10}, {5, 11}, {6, 10}, {6, 11}, {7, 8},
+		{7, 11}, {8, 10}, {9, 10}, {9, 11},
+	})
+	return g
+}
+
+// TutteGraph returns the Tutte graph: a planar, cubic, 3-connected graph on 46 nodes and 69 edges that has no
+// Hamiltonian cycle, the counterexample to Tait's conjecture.
+func TutteGraph() *UndirectedGraph {
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{
+		{0, 1}, {0, 2}, {0, 3}, {1, 4}, {1, 26}, {2, 10}, {2, 11}, {3, 18}, {3, 19},
+		{4, 5}, {4, 33}, {5, 6}, {5, 29}, {6, 7}, {6, 27}, {7, 8}, {7, 14}, {8, 9},
+		{8, 38}, {9, 10}, {9, 37}, {10, 39}, {11, 12}, {11, 39}, {12, 13}, {12, 35},
+		{13, 14}, {13, 15}, {14, 34}, {15, 16}, {15, 22}, {16, 17}, {16, 44}, {17, 18},
+		{17, 43}, {18, 45}, {19, 20}, {19, 45}, {20, 21}, {20, 41}, {21, 22}, {21, 23},
+		{22, 40}, {23, 24}, {23, 27}, {24, 25}, {24, 32}, {25, 26}, {25, 31}, {26, 33},
+		{27, 28}, {28, 29}, {28, 32}, {29, 30}, {30, 31}, {30, 33}, {31, 32}, {34, 35},
+		{34, 38}, {35, 36}, {36, 37}, {36, 39}, {37, 38}, {40, 41}, {40, 44}, {41, 42},
+		{42, 43}, {42, 45}, {43, 44},
+	})
+	return g
+}
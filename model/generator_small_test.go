@@ -0,0 +1,135 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// countAutomorphisms returns the order of the automorphism group of g.
+func countAutomorphisms(g *UndirectedGraph) int {
+	count := 0
+	NewGraphMatcher(g, g, nil, nil).Isomorphisms()(func(map[Node]Node) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+func TestNamedGraphs(t *testing.T) {
+	tests := []struct {
+		name         string
+		graph        *UndirectedGraph
+		nodes        int
+		edges        int
+		degree       int
+		girth        int
+		automorphism int
+	}{
+		{"Petersen", PetersenGraph(), 10, 15, 3, 5, 120},
+		{"Heawood", HeawoodGraph(), 14, 21, 3, 6, 336},
+		{"Dodecahedral", DodecahedralGraph(), 20, 30, 3, 5, 120},
+		{"Cubical", CubicalGraph(), 8, 12, 3, 4, 48},
+		{"Octahedral", OctahedralGraph(), 6, 12, 4, 3, 48},
+		{"Frucht", FruchtGraph(), 12, 18, 3, 3, 1},
+		{"Chvatal", ChvatalGraph(), 12, 24, 4, 4, 8},
+		{"Tutte", TutteGraph(), 46, 69, 3, 4, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := test.graph
+			if len(g.Nodes) != test.nodes || g.NumberOfEdges() != test.edges {
+				t.Errorf("Expected %d nodes and %d edges, but got %d and %d", test.nodes, test.edges, len(g.Nodes), g.NumberOfEdges())
+			}
+			for node := range g.Nodes {
+				if g.NodeDegree(node) != test.degree {
+					t.Errorf("Expected degree %d for node %d, but got %d", test.degree, node, g.NodeDegree(node))
+				}
+			}
+			if girth := Girth(g); girth != test.girth {
+				t.Errorf("Expected girth %d, but got %d", test.girth, girth)
+			}
+			if automorphisms := countAutomorphisms(g); automorphisms != test.automorphism {
+				t.Errorf("Expected %d automorphisms, but got %d", test.automorphism, automorphisms)
+			}
+		})
+	}
+}
+
+func TestNamedGraphsHamiltonicity(t *testing.T) {
+	hamiltonian := []*UndirectedGraph{HeawoodGraph(), DodecahedralGraph(), ChvatalGraph(), FruchtGraph()}
+	for _, g := range hamiltonian {
+		cycle, err := HamiltonianCycle(g, time.Minute)
+		if err != nil || !IsHamiltonianCycle(g, cycle) {
+			t.Errorf("Expected a Hamiltonian cycle, but got %v (%v)", cycle, err)
+		}
+	}
+	nonHamiltonian := []*UndirectedGraph{PetersenGraph()}
+	if !testing.Short() {
+		// Proving that the Tutte graph has no Hamiltonian cycle takes a few seconds
+		nonHamiltonian = append(nonHamiltonian, TutteGraph())
+	}
+	for _, g := range nonHamiltonian {
+		if cycle, err := HamiltonianCycle(g, time.Minute); err != nil || cycle != nil {
+			t.Errorf("Expected no Hamiltonian cycle, but got %v (%v)", cycle, err)
+		}
+	}
+}
+
+func TestNamedGraphsProperties(t *testing.T) {
+	if !IsBipartite(HeawoodGraph()) || !IsBipartite(CubicalGraph()) || IsBipartite(PetersenGraph()) {
+		t.Errorf("Unexpected bipartiteness")
+	}
+	for node, count := range Triangles(ChvatalGraph()) {
+		if count != 0 {
+			t.Errorf("Chvátal graph has %d triangles at node %d", count, node)
+		}
+	}
+	if colors, _ := ChromaticNumber(ChvatalGraph()); colors != 4 {
+		t.Errorf("Expected the Chvátal graph to need 4 colours, but got %d", colors)
+	}
+	if colors, _ := ChromaticNumber(PetersenGraph()); colors != 3 {
+		t.Errorf("Expected the Petersen graph to need 3 colours, but got %d", colors)
+	}
+}
+
+func TestLCFGraph(t *testing.T) {
+	// [3, -3]^4 is the cube, and [3]^6 lists every chord of K_{3,3} from both endpoints
+	g, err := LCFGraph(8, []int{3, -3}, 4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !IsIsomorphic(g, CubicalGraph(), nil, nil) {
+		t.Errorf("Expected the cube, but got %v", g)
+	}
+	g, _ = LCFGraph(6, []int{3}, 6)
+	if !IsIsomorphic(g, CompleteBipartiteGraph(3, 3), nil, nil) {
+		t.Errorf("Expected K_{3,3}, but got %v", g)
+	}
+	if g, _ := LCFGraph(5, nil, 0); !g.Equals(CycleGraph(5)) {
+		t.Errorf("Expected a cycle, but got %v", g)
+	}
+	if _, err := LCFGraph(-1, []int{2}, 1); err == nil {
+		t.Errorf("Expected an error for a negative number of nodes")
+	}
+}
+
+func TestGeneralizedPetersenGraph(t *testing.T) {
+	// GP(4, 1) is the cube and GP(10, 2) the dodecahedron
+	g, err := GeneralizedPetersenGraph(4, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !IsIsomorphic(g, CubicalGraph(), nil, nil) {
+		t.Errorf("Expected the cube, but got %v", g)
+	}
+	g, _ = GeneralizedPetersenGraph(10, 2)
+	if !IsIsomorphic(g, DodecahedralGraph(), nil, nil) {
+		t.Errorf("Expected the dodecahedron, but got %v", g)
+	}
+
+	for _, parameters := range [][2]int{{2, 1}, {6, 3}, {6, 0}} {
+		if _, err := GeneralizedPetersenGraph(parameters[0], parameters[1]); err == nil {
+			t.Errorf("Expected an error for GP(%d, %d)", parameters[0], parameters[1])
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package model
+
+import "fmt"
+
+/*
+KarateClubGraph returns Zachary's karate club network.
+
+Returns:
+- graph: The friendships between the 34 members of a university karate club, 78 edges.
+- club: The faction every member joined after the club split, "Mr. Hi" (the instructor, node 0) or "Officer" (the administrator, node 33).
+
+Description:
+The network was recorded by Wayne Zachary in 1977 while a conflict split the
+club in two, which makes the factions a ground truth for community detection.
+
+Reference: W. W. Zachary, "An information flow model for conflict and fission in small groups", Journal of Anthropological Research, 33, 452-473, 1977.
+*/
+func KarateClubGraph() (*UndirectedGraph, map[Node]string) {
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{
+		{0, 1}, {0, 2}, {0, 3}, {0, 4}, {0, 5}, {0, 6}, {0, 7}, {0, 8}, {0, 10}, {0, 11},
+		{0, 12}, {0, 13}, {0, 17}, {0, 19}, {0, 21}, {0, 31}, {1, 2}, {1, 3}, {1, 7}, {1, 13},
+		{1, 17}, {1, 19}, {1, 21}, {1, 30}, {2, 3}, {2, 7}, {2, 8}, {2, 9}, {2, 13}, {2, 27},
+		{2, 28}, {2, 32}, {3, 7}, {3, 12}, {3, 13}, {4, 6}, {4, 10}, {5, 6}, {5, 10}, {5, 16},
+		{6, 16}, {8, 30}, {8, 32}, {8, 33}, {9, 33}, {13, 33}, {14, 32}, {14, 33}, {15, 32}, {15, 33},
+		{18, 32}, {18, 33}, {19, 33}, {20, 32}, {20, 33}, {22, 32}, {22, 33}, {23, 25}, {23, 27}, {23, 29},
+		{23, 32}, {23, 33}, {24, 25}, {24, 27}, {24, 31}, {25, 31}, {26, 29}, {26, 33}, {27, 33}, {28, 31},
+		{28, 33}, {29, 32}, {29, 33}, {30, 32}, {30, 33}, {31, 32}, {31, 33}, {32, 33},
+	})
+
+	club := make(map[Node]string, 34)
+	for node := range g.Nodes {
+		club[node] = "Officer"
+	}
+	for _, node := range []Node{0, 1, 2, 3, 4, 5, 6, 7, 8, 10, 11, 12, 13, 16, 17, 19, 21} {
+		club[node] = "Mr. Hi"
+	}
+	return g, club
+}
+
+/*
+FlorentineFamiliesGraph returns the marriage alliances between Renaissance Florentine families.
+
+Returns:
+- graph: 15 families and 20 marriage ties; the Pucci family, which has no tie, is left out.
+- families: The name of the family of every node.
+
+Description:
+The network is the classic illustration of how the Medici rose to power by
+brokering between otherwise disconnected families, so they have by far the
+highest betweenness centrality.
+
+Reference: J. F. Padgett and C. K. Ansell, "Robust action and the rise of the Medici, 1400-1434", American Journal of Sociology, 98, 1259-1319, 1993.
+*/
+func FlorentineFamiliesGraph() (*UndirectedGraph, map[Node]string) {
+	names := []string{
+		"Acciaiuoli", "Albizzi", "Barbadori", "Bischeri", "Castellani", "Ginori", "Guadagni", "Lamberteschi",
+		"Medici", "Pazzi", "Peruzzi", "Ridolfi", "Salviati", "Strozzi", "Tornabuoni",
+	}
+	families := make(map[Node]string, len(names))
+	index := make(map[string]Node, len(names))
+	for i, name := range names {
+		families[Node(i)] = name
+		index[name] = Node(i)
+	}
+
+	g := &UndirectedGraph{}
+	for _, tie := range [][2]string{
+		{"Acciaiuoli", "Medici"}, {"Castellani", "Peruzzi"}, {"Castellani", "Strozzi"}, {"Castellani", "Barbadori"},
+		{"Medici", "Barbadori"}, {"Medici", "Ridolfi"}, {"Medici", "Tornabuoni"}, {"Medici", "Albizzi"},
+		{"Medici", "Salviati"}, {"Salviati", "Pazzi"}, {"Peruzzi", "Strozzi"}, {"Peruzzi", "Bischeri"},
+		{"Strozzi", "Ridolfi"}, {"Strozzi", "Bischeri"}, {"Ridolfi", "Tornabuoni"}, {"Tornabuoni", "Guadagni"},
+		{"Albizzi", "Ginori"}, {"Albizzi", "Guadagni"}, {"Bischeri", "Guadagni"}, {"Guadagni", "Lamberteschi"},
+	} {
+		g.AddEdge(Edge{
+			Node1: index[tie[0]],
+			Node2: index[tie[1]],
+		})
+	}
+	return g, families
+}
+
+/*
+LesMiserablesGraph returns the co-appearance network of the characters of Victor Hugo's Les Misérables.
+
+Returns:
+- graph: 77 characters and 254 edges between characters appearing in the same chapter.
+- characters: The name of the character of every node.
+- weights: The number of chapters in which both characters appear, for every edge in both orientations.
+
+Description:
+The data was compiled by Donald Knuth for the Stanford GraphBase; Jean Valjean
+(node 11) is the most connected character. The weights can be passed to the
+weighted algorithms of this package.
+
+Reference: D. E. Knuth, "The Stanford GraphBase: A Platform for Combinatorial Computing", Addison-Wesley, 1993.
+*/
+func LesMiserablesGraph() (*UndirectedGraph, map[Node]string, map[Edge]float64) {
+	names := []string{
+		"Myriel", "Napoleon", "MlleBaptistine", "MmeMagloire", "CountessDeLo", "Geborand", "Champtercier",
+		"Cravatte", "Count", "OldMan", "Labarre", "Valjean", "Marguerite", "MmeDeR", "Isabeau", "Gervais",
+		"Tholomyes", "Listolier", "Fameuil", "Blacheville", "Favourite", "Dahlia", "Zephine", "Fantine",
+		"MmeThenardier", "Thenardier", "Cosette", "Javert", "Fauchelevent", "Bamatabois", "Perpetue",
+		"Simplice", "Scaufflaire", "Woman1", "Judge", "Champmathieu", "Brevet", "Chenildieu", "Cochepaille",
+		"Pontmercy", "Boulatruelle", "Eponine", "Anzelma", "Woman2", "MotherInnocent", "Gribier", "Jondrette",
+		"MmeBurgon", "Gavroche", "Gillenormand", "Magnon", "MlleGillenormand", "MmePontmercy", "MlleVaubois",
+		"LtGillenormand", "Marius", "BaronessT", "Mabeuf", "Enjolras", "Combeferre", "Prouvaire", "Feuilly",
+		"Courfeyrac", "Bahorel", "Bossuet", "Joly", "Grantaire", "MotherPlutarch", "Gueulemer", "Babet",
+		"Claquesous", "Montparnasse", "Toussaint", "Child1", "Child2", "Brujon", "MmeHucheloup",
+	}
+	// Every link is {character, character, number of common chapters}
+	links := [][3]int{
+		{1, 0, 1}, {2, 0, 8}, {3, 0, 10}, {3, 2, 6}, {4, 0, 1}, {5, 0, 1}, {6, 0, 1}, {7, 0, 1}, {8, 0, 2},
+		{9, 0, 1}, {11, 10, 1}, {11, 3, 3}, {11, 2, 3}, {11, 0, 5}, {12, 11, 1}, {13, 11, 1}, {14, 11, 1},
+		{15, 11, 1}, {17, 16, 4}, {18, 16, 4}, {18, 17, 4}, {19, 16, 4}, {19, 17, 4}, {19, 18, 4}, {20, 16, 3},
+		{20, 17, 3}, {20, 18, 3}, {20, 19, 4}, {21, 16, 3}, {21, 17, 3}, {21, 18, 3}, {21, 19, 3}, {21, 20, 5},
+		{22, 16, 3}, {22, 17, 3}, {22, 18, 3}, {22, 19, 3}, {22, 20, 4}, {22, 21, 4}, {23, 16, 3}, {23, 17, 3},
+		{23, 18, 3}, {23, 19, 3}, {23, 20, 4}, {23, 21, 4}, {23, 22, 4}, {23, 12, 2}, {23, 11, 9}, {24, 23, 2},
+		{24, 11, 7}, {25, 24, 13}, {25, 23, 1}, {25, 11, 12}, {26, 24, 4}, {26, 11, 31}, {26, 16, 1}, {26, 25, 1},
+		{27, 11, 17}, {27, 23, 5}, {27, 25, 5}, {27, 24, 1}, {27, 26, 1}, {28, 11, 8}, {28, 27, 1}, {29, 23, 1},
+		{29, 27, 1}, {29, 11, 2}, {30, 23, 1}, {31, 30, 2}, {31, 11, 3}, {31, 23, 2}, {31, 27, 1}, {32, 11, 1},
+		{33, 11, 2}, {33, 27, 1}, {34, 11, 3}, {34, 29, 2}, {35, 11, 3}, {35, 34, 3}, {35, 29, 2}, {36, 34, 2},
+		{36, 35, 2}, {36, 11, 2}, {36, 29, 1}, {37, 34, 2}, {37, 35, 2}, {37, 36, 2}, {37, 11, 2}, {37, 29, 1},
+		{38, 34, 2}, {38, 35, 2}, {38, 36, 2}, {38, 37, 2}, {38, 11, 2}, {38, 29, 1}, {39, 25, 1}, {40, 25, 1},
+		{41, 24, 2}, {41, 25, 3}, {42, 41, 2}, {42, 25, 2}, {42, 24, 1}, {43, 11, 3}, {43, 26, 1}, {43, 27, 1},
+		{44, 28, 3}, {44, 11, 1}, {45, 28, 2}, {47, 46, 1}, {48, 47, 2}, {48, 25, 1}, {48, 27, 1}, {48, 11, 1},
+		{49, 26, 3}, {49, 11, 2}, {50, 49, 1}, {50, 24, 1}, {51, 49, 9}, {51, 26, 2}, {51, 11, 2}, {52, 51, 1},
+		{52, 39, 1}, {53, 51, 1}, {54, 51, 2}, {54, 49, 1}, {54, 26, 1}, {55, 51, 6}, {55, 49, 12}, {55, 39, 1},
+		{55, 54, 1}, {55, 26, 21}, {55, 11, 19}, {55, 16, 1}, {55, 25, 2}, {55, 41, 5}, {55, 48, 4}, {56, 49, 1},
+		{56, 55, 1}, {57, 55, 1}, {57, 41, 1}, {57, 48, 1}, {58, 55, 7}, {58, 48, 7}, {58, 27, 6}, {58, 57, 1},
+		{58, 11, 4}, {59, 58, 15}, {59, 55, 5}, {59, 48, 6}, {59, 57, 2}, {60, 48, 1}, {60, 58, 4}, {60, 59, 2},
+		{61, 48, 2}, {61, 58, 6}, {61, 60, 2}, {61, 59, 5}, {61, 57, 1}, {61, 55, 1}, {62, 55, 9}, {62, 58, 17},
+		{62, 59, 13}, {62, 48, 7}, {62, 57, 2}, {62, 41, 1}, {62, 61, 6}, {62, 60, 3}, {63, 59, 5}, {63, 48, 5},
+		{63, 62, 6}, {63, 57, 2}, {63, 58, 4}, {63, 61, 3}, {63, 60, 2}, {63, 55, 1}, {64, 55, 5}, {64, 62, 12},
+		{64, 48, 5}, {64, 63, 4}, {64, 58, 10}, {64, 61, 6}, {64, 60, 2}, {64, 59, 9}, {64, 57, 1}, {64, 11, 1},
+		{65, 63, 5}, {65, 64, 7}, {65, 48, 3}, {65, 62, 5}, {65, 58, 5}, {65, 61, 5}, {65, 60, 2}, {65, 59, 5},
+		{65, 57, 1}, {65, 55, 2}, {66, 64, 3}, {66, 58, 3}, {66, 59, 1}, {66, 62, 2}, {66, 65, 2}, {66, 48, 1},
+		{66, 63, 1}, {66, 61, 1}, {66, 60, 1}, {67, 57, 3}, {68, 25, 5}, {68, 11, 1}, {68, 24, 1}, {68, 27, 1},
+		{68, 48, 1}, {68, 41, 1}, {69, 25, 6}, {69, 68, 6}, {69, 11, 1}, {69, 24, 1}, {69, 27, 2}, {69, 48, 1},
+		{69, 41, 1}, {70, 25, 4}, {70, 69, 4}, {70, 68, 4}, {70, 11, 1}, {70, 24, 1}, {70, 27, 1}, {70, 41, 1},
+		{70, 58, 1}, {71, 27, 1}, {71, 69, 2}, {71, 68, 2}, {71, 70, 2}, {71, 11, 1}, {71, 48, 1}, {71, 41, 1},
+		{71, 25, 1}, {72, 26, 2}, {72, 27, 1}, {72, 11, 1}, {73, 48, 2}, {74, 48, 2}, {74, 73, 3}, {75, 69, 3},
+		{75, 68, 3}, {75, 25, 3}, {75, 48, 1}, {75, 41, 1}, {75, 70, 1}, {75, 71, 1}, {76, 64, 1}, {76, 65, 1},
+		{76, 66, 1}, {76, 63, 1}, {76, 62, 1}, {76, 48, 1}, {76, 58, 1},
+	}
+
+	g := &UndirectedGraph{}
+	characters := make(map[Node]string, len(names))
+	for i, name := range names {
+		g.AddNode(Node(i))
+		characters[Node(i)] = name
+	}
+	weights := make(map[Edge]float64, 2*len(links))
+	for _, link := range links {
+		edge := Edge{Node1: Node(link[0]), Node2: Node(link[1])}
+		g.AddEdge(edge)
+		weights[edge] = float64(link[2])
+		weights[Edge{Node1: edge.Node2, Node2: edge.Node1}] = float64(link[2])
+	}
+	return g, characters, weights
+}
+
+/*
+DavisSouthernWomenGraph returns the bipartite network of 18 women attending 14 social events in the 1930s American South.
+
+Returns:
+- graph: The women are nodes 0 .. 17 and the events nodes 18 .. 31; each of the 89 edges is an attendance.
+- labels: The name of every woman and "E1" .. "E14" for the events.
+
+Description:
+The data was collected by Davis, Gardner and Gardner and is the standard
+example of two-mode network analysis; the women split into two overlapping
+groups around events E1 .. E9 and E6 .. E14.
+
+Reference: A. Davis, B. B. Gardner and M. R. Gardner, "Deep South", University of Chicago Press, 1941.
+*/
+func DavisSouthernWomenGraph() (*UndirectedGraph, map[Node]string) {
+	women := []string{
+		"Evelyn Jefferson", "Laura Mandeville", "Theresa Anderson", "Brenda Rogers", "Charlotte McDowd",
+		"Frances Anderson", "Eleanor Nye", "Pearl Oglethorpe", "Ruth DeSand", "Verne Sanderson", "Myra Liddel",
+		"Katherina Rogers", "Sylvia Avondale", "Nora Fayette", "Helen Lloyd", "Dorothy Murchison",
+		"Olivia Carleton", "Flora Price",
+	}
+	// The events attended by every woman, numbered from 1
+	attendance := [][]int{
+		{1, 2, 3, 4, 5, 6, 8, 9}, {1, 2, 3, 5, 6, 7, 8}, {2, 3, 4, 5, 6, 7, 8, 9}, {1, 3, 4, 5, 6, 7, 8},
+		{3, 4, 5, 7}, {3, 5, 6, 8}, {5, 6, 7, 8}, {6, 8, 9}, {5, 7, 8, 9}, {7, 8, 9, 12}, {8, 9, 10, 12},
+		{8, 9, 10, 12, 13, 14}, {7, 8, 9, 10, 12, 13, 14}, {6, 7, 9, 10, 11, 12, 13, 14}, {7, 8, 10, 11, 12},
+		{8, 9}, {9, 11}, {9, 11},
+	}
+
+	g := &UndirectedGraph{}
+	labels := make(map[Node]string, len(women)+14)
+	for i, name := range women {
+		labels[Node(i)] = name
+	}
+	for event := 1; event <= 14; event++ {
+		labels[Node(len(women)+event-1)] = fmt.Sprintf("E%d", event)
+	}
+	for woman, events := range attendance {
+		for _, event := range events {
+			g.AddEdge(Edge{
+				Node1: Node(woman),
+				Node2: Node(len(women) + event - 1),
+			})
+		}
+	}
+	return g, labels
+}
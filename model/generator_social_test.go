@@ -0,0 +1,90 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestKarateClubGraph(t *testing.T) {
+	g, club := KarateClubGraph()
+	if len(g.Nodes) != 34 || g.NumberOfEdges() != 78 {
+		t.Errorf("Expected 34 nodes and 78 edges, but got %d and %d", len(g.Nodes), g.NumberOfEdges())
+	}
+	if g.NodeDegree(0) != 16 || g.NodeDegree(33) != 17 || g.NodeDegree(32) != 12 {
+		t.Errorf("Unexpected degrees %d, %d and %d for the leaders", g.NodeDegree(0), g.NodeDegree(33), g.NodeDegree(32))
+	}
+	factions := make(map[string]int)
+	for _, faction := range club {
+		factions[faction]++
+	}
+	if factions["Mr. Hi"] != 17 || factions["Officer"] != 17 || club[0] != "Mr. Hi" || club[33] != "Officer" {
+		t.Errorf("Unexpected factions %v", factions)
+	}
+	// The triangle count is a well known checksum of the data set
+	total := 0
+	for _, count := range Triangles(g) {
+		total += count
+	}
+	if total/3 != 45 {
+		t.Errorf("Expected 45 triangles, but got %d", total/3)
+	}
+}
+
+func TestFlorentineFamiliesGraph(t *testing.T) {
+	g, families := FlorentineFamiliesGraph()
+	if len(g.Nodes) != 15 || g.NumberOfEdges() != 20 || len(families) != 15 {
+		t.Errorf("Expected 15 families and 20 ties, but got %d and %d", len(g.Nodes), g.NumberOfEdges())
+	}
+	degrees := map[string]int{"Medici": 6, "Guadagni": 4, "Strozzi": 4, "Pazzi": 1}
+	for node, name := range families {
+		if expected, ok := degrees[name]; ok && g.NodeDegree(node) != expected {
+			t.Errorf("Expected degree %d for %s, but got %d", expected, name, g.NodeDegree(node))
+		}
+	}
+	if len(ConnectedComponents(g).ComponentsArray) != 1 {
+		t.Errorf("Expected the families to be connected")
+	}
+}
+
+func TestLesMiserablesGraph(t *testing.T) {
+	g, characters, weights := LesMiserablesGraph()
+	if len(g.Nodes) != 77 || g.NumberOfEdges() != 254 || len(characters) != 77 {
+		t.Errorf("Expected 77 characters and 254 edges, but got %d and %d", len(g.Nodes), g.NumberOfEdges())
+	}
+	if characters[11] != "Valjean" || g.NodeDegree(11) != 36 || g.NodeDegree(48) != 22 {
+		t.Errorf("Unexpected degrees %d for %s and %d for %s", g.NodeDegree(11), characters[11], g.NodeDegree(48), characters[48])
+	}
+	total := 0.0
+	for _, edge := range g.GetEdgeTuples() {
+		weight, ok := weights[edge]
+		if !ok {
+			t.Fatalf("Missing weight for edge %v", edge)
+		}
+		total += weight
+	}
+	if total/2 != 820 {
+		t.Errorf("Expected a total weight of 820, but got %v", total/2)
+	}
+	if weights[Edge{Node1: 11, Node2: 26}] != 31 {
+		t.Errorf("Expected Valjean and Cosette to share 31 chapters, but got %v", weights[Edge{Node1: 11, Node2: 26}])
+	}
+}
+
+func TestDavisSouthernWomenGraph(t *testing.T) {
+	g, labels := DavisSouthernWomenGraph()
+	if len(g.Nodes) != 32 || g.NumberOfEdges() != 89 || len(labels) != 32 {
+		t.Errorf("Expected 32 nodes and 89 edges, but got %d and %d", len(g.Nodes), g.NumberOfEdges())
+	}
+	women := make(map[Node]bool)
+	for node := Node(0); node < 18; node++ {
+		women[node] = true
+	}
+	if !IsBipartiteNodeSet(g, women) {
+		t.Errorf("Expected the women to form one side of the bipartition")
+	}
+	if labels[25] != "E8" || g.NodeDegree(25) != 14 || g.NodeDegree(26) != 12 {
+		t.Errorf("Unexpected attendance %d for %s and %d for %s", g.NodeDegree(25), labels[25], g.NodeDegree(26), labels[26])
+	}
+	if labels[0] != "Evelyn Jefferson" || g.NodeDegree(0) != 8 {
+		t.Errorf("Unexpected attendance %d for %s", g.NodeDegree(0), labels[0])
+	}
+}
@@ -0,0 +1,177 @@
+package model
+
+import "fmt"
+
+/*
+FromPruferSequence returns the labelled tree encoded by a Prüfer sequence.
+
+Parameters:
+- sequence: The Prüfer sequence of a tree on len(sequence)+2 nodes; every entry must be in [0, len(sequence)+2).
+
+Returns:
+- tree: The tree on nodes 0 .. len(sequence)+1.
+- error: If an entry of the sequence is out of range.
+
+Description:
+Every labelled tree on n >= 2 nodes has exactly one Prüfer sequence of length
+n-2 and the degree of a node is one more than its number of occurrences in the
+sequence. The tree is decoded in O(n) time by repeatedly joining the smallest
+remaining leaf to the next entry of the sequence.
+
+Example:
+
+	tree, _ := FromPruferSequence([]Node{3, 3, 3})
+	fmt.Println(tree.NodeDegree(3)) // Output: 4
+*/
+func FromPruferSequence(sequence []Node) (*UndirectedGraph, error) {
+	numberOfNodes := len(sequence) + 2
+	degree := make([]int, numberOfNodes)
+	for _, node := range sequence {
+		if node < 0 || int(node) >= numberOfNodes {
+			return nil, fmt.Errorf("prüfer sequence entry %d is out of range [0, %d)", node, numberOfNodes)
+		}
+		degree[node]++
+	}
+	for node := range degree {
+		degree[node]++
+	}
+
+	g := &UndirectedGraph{}
+	// pointer scans for the smallest leaf; a node that becomes a leaf below it is used at once
+	pointer := 0
+	for degree[pointer] != 1 {
+		pointer++
+	}
+	leaf := pointer
+	for _, node := range sequence {
+		g.AddEdge(Edge{
+			Node1: Node(leaf),
+			Node2: node,
+		})
+		degree[leaf]--
+		degree[node]--
+		if degree[node] == 1 && int(node) < pointer {
+			leaf = int(node)
+			continue
+		}
+		pointer++
+		for degree[pointer] != 1 {
+			pointer++
+		}
+		leaf = pointer
+	}
+
+	// Join the two nodes left
+	last := numberOfNodes - 1
+	for last == leaf || degree[last] != 1 {
+		last--
+	}
+	g.AddEdge(Edge{
+		Node1: Node(leaf),
+		Node2: Node(last),
+	})
+	return g, nil
+}
+
+/*
+ToPruferSequence returns the Prüfer sequence of a labelled tree.
+
+Parameters:
+- g: A tree on nodes 0 .. n-1 with n >= 2.
+
+Returns:
+- sequence: The Prüfer sequence of length n-2.
+- error: If the graph has fewer than 2 nodes, is not a tree or its nodes are not 0 .. n-1.
+
+Description:
+The sequence is built by repeatedly removing the smallest leaf and recording
+its neighbour, which FromPruferSequence reverses.
+*/
+func ToPruferSequence(g *UndirectedGraph) ([]Node, error) {
+	numberOfNodes := len(g.Nodes)
+	if numberOfNodes < 2 {
+		return nil, fmt.Errorf("prüfer sequences need at least 2 nodes, got %d", numberOfNodes)
+	}
+	for node := range g.Nodes {
+		if node < 0 || int(node) >= numberOfNodes {
+			return nil, fmt.Errorf("nodes must be labelled 0 .. %d, got %d", numberOfNodes-1, node)
+		}
+	}
+	// A connected graph with n-1 edges, counting self-loops and parallel edges, is a tree
+	if g.NumberOfEdges() != numberOfNodes-1 || len(ConnectedComponents(g).ComponentsArray) != 1 {
+		return nil, fmt.Errorf("graph is not a tree")
+	}
+
+	neighbours := neighbourSets(g)
+	degree := make([]int, numberOfNodes)
+	for node, adjacent := range neighbours {
+		degree[node] = len(adjacent)
+	}
+	removed := make([]bool, numberOfNodes)
+	// parent returns the only neighbour of a leaf that is still in the tree
+	parent := func(leaf int) Node {
+		for neighbor := range neighbours[Node(leaf)] {
+			if !removed[neighbor] {
+				return neighbor
+			}
+		}
+		return -1
+	}
+
+	sequence := make([]Node, 0, numberOfNodes-2)
+	pointer := 0
+	for degree[pointer] != 1 {
+		pointer++
+	}
+	leaf := pointer
+	for len(sequence) < numberOfNodes-2 {
+		next := parent(leaf)
+		sequence = append(sequence, next)
+		removed[leaf] = true
+		degree[next]--
+		if degree[next] == 1 && int(next) < pointer {
+			leaf = int(next)
+			continue
+		}
+		pointer++
+		for degree[pointer] != 1 {
+			pointer++
+		}
+		leaf = pointer
+	}
+	return sequence, nil
+}
+
+// RandomLabelledTree returns a tree chosen uniformly at random among the n^(n-2) labelled trees on nodes 0 .. n-1.
+// The tree is decoded from a uniformly random Prüfer sequence drawn from the source selected by the options.
+func RandomLabelledTree(numberOfNodes int, options ...RandomOption) (*UndirectedGraph, error) {
+	if numberOfNodes < 1 {
+		return nil, fmt.Errorf("number of nodes can't be < 1")
+	}
+	if numberOfNodes == 1 {
+		return TrivialGraph(), nil
+	}
+	random := newRandom(options...)
+	sequence := make([]Node, numberOfNodes-2)
+	for i := range sequence {
+		sequence[i] = Node(random.Intn(numberOfNodes))
+	}
+	return FromPruferSequence(sequence)
+}
+
+// RandomRecursiveTree returns a random recursive tree on nodes 0 .. n-1, in which every node i > 0 is attached
+// to a node chosen uniformly at random among 0 .. i-1. Unlike RandomLabelledTree, the labels grow along every path from 0.
+func RandomRecursiveTree(numberOfNodes int, options ...RandomOption) (*UndirectedGraph, error) {
+	if numberOfNodes < 1 {
+		return nil, fmt.Errorf("number of nodes can't be < 1")
+	}
+	random := newRandom(options...)
+	g := TrivialGraph()
+	for i := 1; i < numberOfNodes; i++ {
+		g.AddEdge(Edge{
+			Node1: Node(random.Intn(i)),
+			Node2: Node(i),
+		})
+	}
+	return g, nil
+}
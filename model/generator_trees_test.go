@@ -0,0 +1,123 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestFromPruferSequence(t *testing.T) {
+	tests := []struct {
+		name          string
+		sequence      []Node
+		expectedEdges map[Node][]Node
+	}{
+		{"SingleEdge", []Node{}, map[Node][]Node{0: {1}, 1: {0}}},
+		{"Star", []Node{3, 3, 3}, map[Node][]Node{0: {3}, 1: {3}, 2: {3}, 3: {0, 1, 2, 4}, 4: {3}}},
+		{"Path", []Node{1, 2, 3}, map[Node][]Node{0: {1}, 1: {0, 2}, 2: {1, 3}, 3: {2, 4}, 4: {3}}},
+		{"Mixed", []Node{3, 3, 3, 4}, map[Node][]Node{0: {3}, 1: {3}, 2: {3}, 3: {0, 1, 2, 4}, 4: {3, 5}, 5: {4}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g, err := FromPruferSequence(test.sequence)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			nodes := make(map[Node]bool)
+			for node := range test.expectedEdges {
+				nodes[node] = true
+			}
+			validateGraph(t, g, nodes, test.expectedEdges)
+		})
+	}
+
+	if _, err := FromPruferSequence([]Node{0, 4}); err == nil {
+		t.Errorf("Expected an error for an entry out of range")
+	}
+}
+
+func TestToPruferSequence(t *testing.T) {
+	sequences := [][]Node{{}, {0}, {3, 3, 3}, {1, 2, 3}, {4, 0, 4, 2, 6}, {5, 5, 0, 0, 1, 1}}
+	for _, sequence := range sequences {
+		tree, _ := FromPruferSequence(sequence)
+		decoded, err := ToPruferSequence(tree)
+		if err != nil {
+			t.Fatalf("Unexpected error for %v: %v", sequence, err)
+		}
+		if len(decoded) != len(sequence) {
+			t.Fatalf("Expected %v, but got %v", sequence, decoded)
+		}
+		for i := range sequence {
+			if decoded[i] != sequence[i] {
+				t.Errorf("Expected %v, but got %v", sequence, decoded)
+				break
+			}
+		}
+	}
+
+	shifted, _ := RelabelNodes(PathGraph(3), map[Node]Node{0: 1, 1: 2, 2: 3}, false)
+	invalid := []*UndirectedGraph{TrivialGraph(), CycleGraph(4), shifted}
+	forest := PathGraph(2)
+	forest.AddEdge(Edge{Node1: 2, Node2: 3})
+	invalid = append(invalid, forest)
+	for _, g := range invalid {
+		if _, err := ToPruferSequence(g); err == nil {
+			t.Errorf("Expected an error for %v", g)
+		}
+	}
+}
+
+func TestRandomLabelledTree(t *testing.T) {
+	for _, n := range []int{1, 2, 10, 100} {
+		tree, err := RandomLabelledTree(n)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(tree.Nodes) != n || tree.NumberOfEdges() != n-1 || len(ConnectedComponents(tree).ComponentsArray) != 1 {
+			t.Errorf("Expected a tree on %d nodes, but got %v", n, tree)
+		}
+	}
+
+	// Cayley's formula: the 3 labelled trees on 3 nodes are equally likely
+	centres := make(map[Node]int)
+	for i := 0; i < 3000; i++ {
+		tree, _ := RandomLabelledTree(3)
+		for node := range tree.Nodes {
+			if tree.NodeDegree(node) == 2 {
+				centres[node]++
+			}
+		}
+	}
+	for node := Node(0); node < 3; node++ {
+		if centres[node] < 850 || centres[node] > 1150 {
+			t.Errorf("Node %d is the centre %d times out of 3000", node, centres[node])
+		}
+	}
+
+	if _, err := RandomLabelledTree(0); err == nil {
+		t.Errorf("Expected an error for an empty tree")
+	}
+}
+
+func TestRandomRecursiveTree(t *testing.T) {
+	tree, err := RandomRecursiveTree(50)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(tree.Nodes) != 50 || tree.NumberOfEdges() != 49 || len(ConnectedComponents(tree).ComponentsArray) != 1 {
+		t.Errorf("Expected a tree on 50 nodes, but got %v", tree)
+	}
+	// Every node is attached to an older one
+	for node := Node(1); node < 50; node++ {
+		older := 0
+		for _, neighbor := range tree.Edges[node] {
+			if neighbor < node {
+				older++
+			}
+		}
+		if older != 1 {
+			t.Errorf("Node %d has %d older neighbours", node, older)
+		}
+	}
+	if _, err := RandomRecursiveTree(-1); err == nil {
+		t.Errorf("Expected an error for a negative number of nodes")
+	}
+}
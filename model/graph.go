@@ -332,6 +332,16 @@ func (g *UndirectedGraph) HasNode(node Node) bool {
 	return g.Nodes[node]
 }
 
+// HasEdge checks if the UndirectedGraph contains an edge between edge.Node1 and edge.Node2.
+func (g *UndirectedGraph) HasEdge(edge Edge) bool {
+	for _, neighbor := range g.Edges[edge.Node1] {
+		if neighbor == edge.Node2 {
+			return true
+		}
+	}
+	return false
+}
+
 /*
 RemoveEdge removes an undirected edge from the UndirectedGraph.
 
@@ -459,6 +469,22 @@ func (g *UndirectedGraph) ContractEdge(edge Edge) {
 	delete(g.Edges, node1)
 }
 
+// ToDirected returns a DirectedGraph in which every undirected edge u - v is
+// replaced by the two directed edges u -> v and v -> u.
+func (g *UndirectedGraph) ToDirected() *DirectedGraph {
+	directed := &DirectedGraph{
+		Nodes: make(map[Node]bool, len(g.Nodes)),
+		Edges: make(map[Node][]Node),
+	}
+	for node := range g.Nodes {
+		directed.AddNode(node)
+	}
+	for _, edge := range g.GetEdgeTuples() {
+		directed.AddEdge(edge)
+	}
+	return directed
+}
+
 // ConnectedComponents finds the connected components in an undirected graph.
 // It takes an undirected graph (g) as input and returns a Components struct.
 // The Components struct contains an array of UndirectedGraphs, each representing
@@ -0,0 +1,705 @@
+package model
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+/*
+EditCosts configures the cost of every elementary edit operation of the graph edit distance.
+
+Description:
+Every field is optional: nil substitution costs are 0 and nil deletion and
+insertion costs are 1, which makes the distance count the nodes and edges to
+add or remove. Costs must not be negative. Node attributes can be taken into
+account through closures, e.g. a substitution cost of 1 between nodes of
+different types.
+*/
+type EditCosts struct {
+	NodeSubstitution func(node1 Node, node2 Node) float64
+	NodeDeletion     func(node Node) float64
+	NodeInsertion    func(node Node) float64
+	EdgeSubstitution func(edge1 Edge, edge2 Edge) float64
+	EdgeDeletion     func(edge Edge) float64
+	EdgeInsertion    func(edge Edge) float64
+}
+
+func (costs *EditCosts) nodeSubstitution(node1 Node, node2 Node) float64 {
+	if costs == nil || costs.NodeSubstitution == nil {
+		return 0
+	}
+	return costs.NodeSubstitution(node1, node2)
+}
+
+func (costs *EditCosts) nodeDeletion(node Node) float64 {
+	if costs == nil || costs.NodeDeletion == nil {
+		return 1
+	}
+	return costs.NodeDeletion(node)
+}
+
+func (costs *EditCosts) nodeInsertion(node Node) float64 {
+	if costs == nil || costs.NodeInsertion == nil {
+		return 1
+	}
+	return costs.NodeInsertion(node)
+}
+
+func (costs *EditCosts) edgeSubstitution(edge1 Edge, edge2 Edge) float64 {
+	if costs == nil || costs.EdgeSubstitution == nil {
+		return 0
+	}
+	return costs.EdgeSubstitution(edge1, edge2)
+}
+
+func (costs *EditCosts) edgeDeletion(edge Edge) float64 {
+	if costs == nil || costs.EdgeDeletion == nil {
+		return 1
+	}
+	return costs.EdgeDeletion(edge)
+}
+
+func (costs *EditCosts) edgeInsertion(edge Edge) float64 {
+	if costs == nil || costs.EdgeInsertion == nil {
+		return 1
+	}
+	return costs.EdgeInsertion(edge)
+}
+
+// editPathCost returns the cost of transforming g1 into g2 when g1 nodes are substituted
+// according to mapping, g1 nodes missing from mapping are deleted and g2 nodes that are
+// not images are inserted, together with the implied edge operations.
+func editPathCost(g1 *UndirectedGraph, g2 *UndirectedGraph, costs *EditCosts, mapping map[Node]Node) float64 {
+	neighbours1, neighbours2 := neighbourSets(g1), neighbourSets(g2)
+	images := make(map[Node]bool, len(mapping))
+	total := 0.0
+	for node := range g1.Nodes {
+		if image, ok := mapping[node]; ok {
+			images[image] = true
+			total += costs.nodeSubstitution(node, image)
+		} else {
+			total += costs.nodeDeletion(node)
+		}
+	}
+	for node := range g2.Nodes {
+		if !images[node] {
+			total += costs.nodeInsertion(node)
+		}
+	}
+
+	preimage := make(map[Node]Node, len(mapping))
+	for node, image := range mapping {
+		preimage[image] = node
+	}
+	for u, adjacent := range neighbours1 {
+		for v := range adjacent {
+			if u > v {
+				continue
+			}
+			edge := Edge{Node1: u, Node2: v}
+			imageU, okU := mapping[u]
+			imageV, okV := mapping[v]
+			if okU && okV && neighbours2[imageU][imageV] {
+				total += costs.edgeSubstitution(edge, Edge{Node1: imageU, Node2: imageV})
+			} else {
+				total += costs.edgeDeletion(edge)
+			}
+		}
+	}
+	for u, adjacent := range neighbours2 {
+		for v := range adjacent {
+			if u > v {
+				continue
+			}
+			preU, okU := preimage[u]
+			preV, okV := preimage[v]
+			if !okU || !okV || !neighbours1[preU][preV] {
+				total += costs.edgeInsertion(Edge{Node1: u, Node2: v})
+			}
+		}
+	}
+	return total
+}
+
+// gedState is a partial edit path of the A* search: the first len(images) nodes
+// of g1 are mapped to the g2 node of the same index, or deleted when the index is -1.
+type gedState struct {
+	cost     float64
+	bound    float64
+	images   []int
+	complete bool
+}
+
+type gedQueue []*gedState
+
+func (q gedQueue) Len() int { return len(q) }
+func (q gedQueue) Less(i, j int) bool {
+	if q[i].bound != q[j].bound {
+		return q[i].bound < q[j].bound
+	}
+	// Prefer deeper states to reach complete edit paths early
+	return len(q[i].images) > len(q[j].images)
+}
+func (q gedQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *gedQueue) Push(x any) { *q = append(*q, x.(*gedState)) }
+
+func (q *gedQueue) Pop() any {
+	old := *q
+	state := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return state
+}
+
+/*
+GraphEditDistance computes the exact graph edit distance between two graphs.
+
+Parameters:
+- g1, g2: The undirected graphs.
+- costs: The cost of every edit operation; nil uses unit costs (see EditCosts).
+- timeout: The maximum duration of the search; zero or negative means no limit.
+
+Returns:
+- distance: The minimum total cost of node and edge substitutions, deletions and insertions transforming g1 into g2.
+- mapping: An optimal node mapping from g1 to g2; g1 nodes missing from it are deleted and unmapped g2 nodes are inserted.
+- error: If the search was interrupted by the timeout.
+
+Description:
+The function runs an A* search over partial node mappings, processing g1 nodes
+by decreasing degree. The heuristic counts the nodes and edges that must still
+be deleted or inserted, which never overestimates the remaining cost. The
+problem is NP-hard and the search only suits graphs of roughly a dozen nodes;
+see ApproximateGraphEditDistance for larger ones. Self-loops and parallel
+edges are ignored.
+
+Example:
+
+	distance, _, _ := GraphEditDistance(CycleGraph(4), PathGraph(4), nil, time.Second)
+	fmt.Println(distance) // Output: 1
+*/
+func GraphEditDistance(g1 *UndirectedGraph, g2 *UndirectedGraph, costs *EditCosts, timeout time.Duration) (float64, map[Node]Node, error) {
+	nodes1, _, adjacency1 := simpleAdjacency(g1)
+	nodes2, _, adjacency2 := simpleAdjacency(g2)
+	n1, n2 := len(nodes1), len(nodes2)
+
+	// Process high degree nodes first, their edges prune the search sooner
+	order := make([]int, n1)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return len(adjacency1[order[i]]) > len(adjacency1[order[j]]) })
+	adjacent1 := make([]map[int]bool, n1)
+	for v, neighbours := range adjacency1 {
+		adjacent1[v] = make(map[int]bool, len(neighbours))
+		for _, w := range neighbours {
+			adjacent1[v][w] = true
+		}
+	}
+	adjacent2 := make([]map[int]bool, n2)
+	for v, neighbours := range adjacency2 {
+		adjacent2[v] = make(map[int]bool, len(neighbours))
+		for _, w := range neighbours {
+			adjacent2[v][w] = true
+		}
+	}
+	edge1 := func(a, b int) Edge { return Edge{Node1: nodes1[a], Node2: nodes1[b]} }
+	edge2 := func(a, b int) Edge { return Edge{Node1: nodes2[a], Node2: nodes2[b]} }
+
+	// extensionCost returns the cost of mapping the next g1 node to image (-1 for a deletion)
+	extensionCost := func(images []int, image int) float64 {
+		u := order[len(images)]
+		cost := 0.0
+		if image < 0 {
+			cost += costs.nodeDeletion(nodes1[u])
+		} else {
+			cost += costs.nodeSubstitution(nodes1[u], nodes2[image])
+		}
+		for idx, other := range images {
+			w := order[idx]
+			has1 := adjacent1[u][w]
+			has2 := image >= 0 && other >= 0 && adjacent2[image][other]
+			switch {
+			case has1 && has2:
+				cost += costs.edgeSubstitution(edge1(u, w), edge2(image, other))
+			case has1:
+				cost += costs.edgeDeletion(edge1(u, w))
+			case has2:
+				cost += costs.edgeInsertion(edge2(image, other))
+			}
+		}
+		return cost
+	}
+
+	// completionCost inserts the g2 nodes left unused, with their edges
+	completionCost := func(images []int) float64 {
+		used := make([]bool, n2)
+		for _, image := range images {
+			if image >= 0 {
+				used[image] = true
+			}
+		}
+		cost := 0.0
+		for v := 0; v < n2; v++ {
+			if used[v] {
+				continue
+			}
+			cost += costs.nodeInsertion(nodes2[v])
+			for _, w := range adjacency2[v] {
+				if used[w] || w > v {
+					cost += costs.edgeInsertion(edge2(v, w))
+				}
+			}
+		}
+		return cost
+	}
+
+	// lowerBound counts the deletions and insertions that cannot be avoided
+	lowerBound := func(images []int) float64 {
+		used := make([]bool, n2)
+		for _, image := range images {
+			if image >= 0 {
+				used[image] = true
+			}
+		}
+		remaining1 := order[len(images):]
+		inRemaining1 := make([]bool, n1)
+		for _, u := range remaining1 {
+			inRemaining1[u] = true
+		}
+
+		minDeletion, minInsertion := math.Inf(1), math.Inf(1)
+		nodesLeft2 := 0
+		for _, u := range remaining1 {
+			minDeletion = min(minDeletion, costs.nodeDeletion(nodes1[u]))
+		}
+		for v := 0; v < n2; v++ {
+			if !used[v] {
+				nodesLeft2++
+				minInsertion = min(minInsertion, costs.nodeInsertion(nodes2[v]))
+			}
+		}
+		bound := 0.0
+		if len(remaining1) > nodesLeft2 {
+			bound += float64(len(remaining1)-nodesLeft2) * minDeletion
+		} else if nodesLeft2 > len(remaining1) {
+			bound += float64(nodesLeft2-len(remaining1)) * minInsertion
+		}
+
+		minEdgeDeletion, minEdgeInsertion := math.Inf(1), math.Inf(1)
+		edgesLeft1, edgesLeft2 := 0, 0
+		for _, u := range remaining1 {
+			for _, w := range adjacency1[u] {
+				if inRemaining1[w] && u < w {
+					edgesLeft1++
+					minEdgeDeletion = min(minEdgeDeletion, costs.edgeDeletion(edge1(u, w)))
+				}
+			}
+		}
+		for v := 0; v < n2; v++ {
+			for _, w := range adjacency2[v] {
+				if !used[v] && !used[w] && v < w {
+					edgesLeft2++
+					minEdgeInsertion = min(minEdgeInsertion, costs.edgeInsertion(edge2(v, w)))
+				}
+			}
+		}
+		if edgesLeft1 > edgesLeft2 {
+			bound += float64(edgesLeft1-edgesLeft2) * minEdgeDeletion
+		} else if edgesLeft2 > edgesLeft1 {
+			bound += float64(edgesLeft2-edgesLeft1) * minEdgeInsertion
+		}
+		return bound
+	}
+
+	deadline := time.Now().Add(timeout)
+	queue := &gedQueue{{bound: lowerBound(nil)}}
+	for pops := 1; queue.Len() > 0; pops++ {
+		if pops%1024 == 0 && timeout > 0 && time.Now().After(deadline) {
+			return 0, nil, fmt.Errorf("graph edit distance search timed out after %v", timeout)
+		}
+		state := heap.Pop(queue).(*gedState)
+		if state.complete {
+			mapping := make(map[Node]Node)
+			for idx, image := range state.images {
+				if image >= 0 {
+					mapping[nodes1[order[idx]]] = nodes2[image]
+				}
+			}
+			return state.cost, mapping, nil
+		}
+		if len(state.images) == n1 {
+			cost := state.cost + completionCost(state.images)
+			heap.Push(queue, &gedState{cost: cost, bound: cost, images: state.images, complete: true})
+			continue
+		}
+
+		used := make([]bool, n2)
+		for _, image := range state.images {
+			if image >= 0 {
+				used[image] = true
+			}
+		}
+		for image := -1; image < n2; image++ {
+			if image >= 0 && used[image] {
+				continue
+			}
+			images := append(append(make([]int, 0, len(state.images)+1), state.images...), image)
+			cost := state.cost + extensionCost(state.images, image)
+			heap.Push(queue, &gedState{cost: cost, bound: cost + lowerBound(images), images: images})
+		}
+	}
+	return 0, map[Node]Node{}, nil
+}
+
+/*
+ApproximateGraphEditDistance computes an upper bound of the graph edit distance in polynomial time.
+
+Parameters:
+- g1, g2: The undirected graphs.
+- costs: The cost of every edit operation; nil uses unit costs (see EditCosts).
+
+Returns:
+- distance: The exact cost of the edit path induced by mapping, which is never smaller than the graph edit distance.
+- mapping: The node mapping from g1 to g2; g1 nodes missing from it are deleted and unmapped g2 nodes are inserted.
+
+Description:
+The function implements the bipartite approximation of Riesen and Bunke: a
+square cost matrix holds the cost of substituting, deleting or inserting every
+node together with the optimal assignment of its incident edges, and the
+Hungarian algorithm picks the cheapest node assignment in O((V1+V2)³) time.
+Self-loops and parallel edges are ignored.
+*/
+func ApproximateGraphEditDistance(g1 *UndirectedGraph, g2 *UndirectedGraph, costs *EditCosts) (float64, map[Node]Node) {
+	nodes1, _, adjacency1 := simpleAdjacency(g1)
+	nodes2, _, adjacency2 := simpleAdjacency(g2)
+	n1, n2 := len(nodes1), len(nodes2)
+	size := n1 + n2
+	if size == 0 {
+		return 0, map[Node]Node{}
+	}
+	edges1 := func(u int) []Edge {
+		edges := make([]Edge, len(adjacency1[u]))
+		for i, w := range adjacency1[u] {
+			edges[i] = Edge{Node1: nodes1[u], Node2: nodes1[w]}
+		}
+		return edges
+	}
+	edges2 := func(v int) []Edge {
+		edges := make([]Edge, len(adjacency2[v]))
+		for i, w := range adjacency2[v] {
+			edges[i] = Edge{Node1: nodes2[v], Node2: nodes2[w]}
+		}
+		return edges
+	}
+
+	matrix := make([][]float64, size)
+	for i := range matrix {
+		matrix[i] = make([]float64, size)
+	}
+	forbidden := 1.0
+	for u := 0; u < n1; u++ {
+		for v := 0; v < n2; v++ {
+			// Every edge is shared by two nodes, so the local edge cost is halved
+			matrix[u][v] = costs.nodeSubstitution(nodes1[u], nodes2[v]) + incidentEdgeAssignmentCost(edges1(u), edges2(v), costs)/2
+			forbidden += matrix[u][v]
+		}
+	}
+	for u := 0; u < n1; u++ {
+		cost := costs.nodeDeletion(nodes1[u])
+		for _, edge := range edges1(u) {
+			cost += costs.edgeDeletion(edge) / 2
+		}
+		matrix[u][n2+u] = cost
+		forbidden += cost
+	}
+	for v := 0; v < n2; v++ {
+		cost := costs.nodeInsertion(nodes2[v])
+		for _, edge := range edges2(v) {
+			cost += costs.edgeInsertion(edge) / 2
+		}
+		matrix[n1+v][v] = cost
+		forbidden += cost
+	}
+	// Deletion and insertion blocks only allow their diagonal; the bottom right block is free
+	for u := 0; u < n1; u++ {
+		for j := n2; j < size; j++ {
+			if j != n2+u {
+				matrix[u][j] = forbidden
+			}
+		}
+	}
+	for i := n1; i < size; i++ {
+		for v := 0; v < n2; v++ {
+			if i != n1+v {
+				matrix[i][v] = forbidden
+			}
+		}
+	}
+
+	assignment := hungarianAssignment(matrix)
+	mapping := make(map[Node]Node)
+	for u := 0; u < n1; u++ {
+		if assignment[u] < n2 {
+			mapping[nodes1[u]] = nodes2[assignment[u]]
+		}
+	}
+	return editPathCost(g1, g2, costs, mapping), mapping
+}
+
+// incidentEdgeAssignmentCost returns the cost of the optimal assignment of the
+// edges incident to a node of g1 onto those incident to a node of g2.
+func incidentEdgeAssignmentCost(edges1 []Edge, edges2 []Edge, costs *EditCosts) float64 {
+	size := len(edges1) + len(edges2)
+	if size == 0 {
+		return 0
+	}
+	matrix := make([][]float64, size)
+	for i := range matrix {
+		matrix[i] = make([]float64, size)
+	}
+	forbidden := 1.0
+	for i, edge1 := range edges1 {
+		for j, edge2 := range edges2 {
+			matrix[i][j] = costs.edgeSubstitution(edge1, edge2)
+			forbidden += matrix[i][j]
+		}
+		forbidden += costs.edgeDeletion(edge1)
+	}
+	for _, edge2 := range edges2 {
+		forbidden += costs.edgeInsertion(edge2)
+	}
+	for i, edge1 := range edges1 {
+		for j := len(edges2); j < size; j++ {
+			matrix[i][j] = forbidden
+		}
+		matrix[i][len(edges2)+i] = costs.edgeDeletion(edge1)
+	}
+	for j, edge2 := range edges2 {
+		for i := len(edges1); i < size; i++ {
+			matrix[i][j] = forbidden
+		}
+		matrix[len(edges1)+j][j] = costs.edgeInsertion(edge2)
+	}
+
+	total := 0.0
+	for i, j := range hungarianAssignment(matrix) {
+		total += matrix[i][j]
+	}
+	return total
+}
+
+// hungarianAssignment solves the square assignment problem and returns, for every
+// row, the column assigned to it, minimising the total cost in O(n³).
+func hungarianAssignment(cost [][]float64) []int {
+	n := len(cost)
+	// Potentials and matching use 1-based indices, column 0 being a sentinel
+	rowPotential := make([]float64, n+1)
+	columnPotential := make([]float64, n+1)
+	columnMatch := make([]int, n+1)
+	way := make([]int, n+1)
+	for row := 1; row <= n; row++ {
+		columnMatch[0] = row
+		column := 0
+		minimum := make([]float64, n+1)
+		used := make([]bool, n+1)
+		for j := range minimum {
+			minimum[j] = math.Inf(1)
+		}
+		for columnMatch[column] != 0 {
+			used[column] = true
+			current, delta, next := columnMatch[column], math.Inf(1), 0
+			for j := 1; j <= n; j++ {
+				if used[j] {
+					continue
+				}
+				reduced := cost[current-1][j-1] - rowPotential[current] - columnPotential[j]
+				if reduced < minimum[j] {
+					minimum[j], way[j] = reduced, column
+				}
+				if minimum[j] < delta {
+					delta, next = minimum[j], j
+				}
+			}
+			for j := 0; j <= n; j++ {
+				if used[j] {
+					rowPotential[columnMatch[j]] += delta
+					columnPotential[j] -= delta
+				} else {
+					minimum[j] -= delta
+				}
+			}
+			column = next
+		}
+		for column != 0 {
+			previous := way[column]
+			columnMatch[column] = columnMatch[previous]
+			column = previous
+		}
+	}
+
+	assignment := make([]int, n)
+	for j := 1; j <= n; j++ {
+		assignment[columnMatch[j]-1] = j - 1
+	}
+	return assignment
+}
+
+// symmetricEigenvalues returns the eigenvalues of a symmetric matrix in decreasing
+// order, computed with the cyclic Jacobi rotation method.
+func symmetricEigenvalues(matrix [][]float64) []float64 {
+	n := len(matrix)
+	a := make([][]float64, n)
+	for i := range a {
+		a[i] = append([]float64(nil), matrix[i]...)
+	}
+
+	for sweep := 0; sweep < 100; sweep++ {
+		offDiagonal := 0.0
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				offDiagonal += a[i][j] * a[i][j]
+			}
+		}
+		if offDiagonal < 1e-22 {
+			break
+		}
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if math.Abs(a[p][q]) < 1e-300 {
+					continue
+				}
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+				for k := 0; k < n; k++ {
+					akp, akq := a[k][p], a[k][q]
+					a[k][p] = c*akp - s*akq
+					a[k][q] = s*akp + c*akq
+				}
+				for k := 0; k < n; k++ {
+					apk, aqk := a[p][k], a[q][k]
+					a[p][k] = c*apk - s*aqk
+					a[q][k] = s*apk + c*aqk
+				}
+			}
+		}
+	}
+
+	eigenvalues := make([]float64, n)
+	for i := range eigenvalues {
+		eigenvalues[i] = a[i][i]
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(eigenvalues)))
+	return eigenvalues
+}
+
+/*
+SpectralDistance returns the Euclidean distance between the spectra of two graphs.
+
+Parameters:
+- g1, g2: The undirected graphs.
+- laplacian: If true, the eigenvalues of the Laplacian matrix D - A are compared, otherwise those of the adjacency matrix A.
+
+Returns:
+- The Euclidean distance between the eigenvalues sorted in decreasing order; the smaller spectrum is padded with zeros, the eigenvalue of isolated nodes.
+
+Description:
+Isomorphic graphs have distance 0, although cospectral non-isomorphic graphs
+exist. The eigenvalues are computed with the Jacobi method in O(V³) per sweep,
+so the function suits graphs of up to a few hundred nodes. Self-loops and
+parallel edges are ignored.
+*/
+func SpectralDistance(g1 *UndirectedGraph, g2 *UndirectedGraph, laplacian bool) float64 {
+	spectrum := func(g *UndirectedGraph) []float64 {
+		_, _, adjacency := simpleAdjacency(g)
+		matrix := make([][]float64, len(adjacency))
+		for v, neighbours := range adjacency {
+			matrix[v] = make([]float64, len(adjacency))
+			for _, w := range neighbours {
+				matrix[v][w] = 1
+				if laplacian {
+					matrix[v][w] = -1
+				}
+			}
+			if laplacian {
+				matrix[v][v] = float64(len(neighbours))
+			}
+		}
+		return symmetricEigenvalues(matrix)
+	}
+
+	spectrum1, spectrum2 := spectrum(g1), spectrum(g2)
+	// The padding zeros are sorted in among the eigenvalues
+	for len(spectrum1) < len(spectrum2) {
+		spectrum1 = append(spectrum1, 0)
+	}
+	for len(spectrum2) < len(spectrum1) {
+		spectrum2 = append(spectrum2, 0)
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(spectrum1)))
+	sort.Sort(sort.Reverse(sort.Float64Slice(spectrum2)))
+
+	sum := 0.0
+	for i := range spectrum1 {
+		sum += (spectrum1[i] - spectrum2[i]) * (spectrum1[i] - spectrum2[i])
+	}
+	return math.Sqrt(sum)
+}
+
+/*
+DegreeDistributionDistance returns the Kolmogorov-Smirnov statistic between the degree distributions of two graphs.
+
+Parameters:
+- g1, g2: The undirected graphs.
+
+Returns:
+- The largest absolute difference between the empirical cumulative degree distributions, between 0 and 1. It is 0 when both graphs are empty and 1 when only one of them is.
+
+Description:
+The statistic compares the shapes of the distributions and not the graph
+sizes, which makes it suitable to check how well a sample preserves the degree
+distribution of the original graph.
+
+Example:
+
+	fmt.Println(DegreeDistributionDistance(CycleGraph(5), CycleGraph(50))) // Output: 0
+*/
+func DegreeDistributionDistance(g1 *UndirectedGraph, g2 *UndirectedGraph) float64 {
+	degrees := func(g *UndirectedGraph) []int {
+		sequence := make([]int, 0, len(g.Nodes))
+		for node := range g.Nodes {
+			sequence = append(sequence, g.NodeDegree(node))
+		}
+		sort.Ints(sequence)
+		return sequence
+	}
+	degrees1, degrees2 := degrees(g1), degrees(g2)
+	if len(degrees1) == 0 || len(degrees2) == 0 {
+		if len(degrees1) == len(degrees2) {
+			return 0
+		}
+		return 1
+	}
+
+	statistic := 0.0
+	i, j := 0, 0
+	for i < len(degrees1) && j < len(degrees2) {
+		value := min(degrees1[i], degrees2[j])
+		for i < len(degrees1) && degrees1[i] == value {
+			i++
+		}
+		for j < len(degrees2) && degrees2[j] == value {
+			j++
+		}
+		difference := math.Abs(float64(i)/float64(len(degrees1)) - float64(j)/float64(len(degrees2)))
+		statistic = math.Max(statistic, difference)
+	}
+	return statistic
+}
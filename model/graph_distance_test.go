@@ -0,0 +1,158 @@
+package model
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGraphEditDistance(t *testing.T) {
+	shuffled, _ := RelabelNodes(CycleGraph(5), map[Node]Node{0: 3, 1: 0, 2: 4, 3: 1, 4: 2}, false)
+	tests := []struct {
+		name     string
+		g1       *UndirectedGraph
+		g2       *UndirectedGraph
+		expected float64
+	}{
+		{"CycleToPath", CycleGraph(4), PathGraph(4), 1},
+		{"Isomorphic", CycleGraph(5), shuffled, 0},
+		{"FromNullGraph", NullGraph(), CompleteGraph(3), 6},
+		{"ToNullGraph", StarGraph(4), NullGraph(), 7},
+		{"StarToPath", StarGraph(4), PathGraph(4), 2},
+		{"TrianglesToSquare", twoTriangles(), CycleGraph(4), 8},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			distance, mapping, err := GraphEditDistance(test.g1, test.g2, nil, time.Minute)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if distance != test.expected {
+				t.Errorf("Expected distance %v, but got %v", test.expected, distance)
+			}
+			if cost := editPathCost(test.g1, test.g2, nil, mapping); cost != distance {
+				t.Errorf("Mapping %v costs %v instead of %v", mapping, cost, distance)
+			}
+		})
+	}
+}
+
+func TestGraphEditDistanceCosts(t *testing.T) {
+	// Even nodes are red and odd nodes blue: relabelling costs 1 when the colours differ
+	costs := &EditCosts{
+		NodeSubstitution: func(node1 Node, node2 Node) float64 {
+			if node1%2 != node2%2 {
+				return 1
+			}
+			return 0
+		},
+		EdgeDeletion:  func(edge Edge) float64 { return 10 },
+		EdgeInsertion: func(edge Edge) float64 { return 10 },
+	}
+	g1 := PathGraph(3)
+	g2, _ := RelabelNodes(PathGraph(3), map[Node]Node{0: 1, 1: 2, 2: 3}, false)
+	distance, mapping, err := GraphEditDistance(g1, g2, costs, time.Minute)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// Keeping both edges forces the centre 1 onto 2; the ends 0 and 2 go to 1 and 3
+	if distance != 3 || mapping[1] != 2 {
+		t.Errorf("Expected distance 3 with 1 mapped to 2, but got %v with %v", distance, mapping)
+	}
+}
+
+func TestGraphEditDistanceTimeout(t *testing.T) {
+	if _, _, err := GraphEditDistance(CompleteGraph(12), CycleGraph(12), nil, time.Nanosecond); err == nil {
+		t.Errorf("Expected a timeout error")
+	}
+}
+
+func TestApproximateGraphEditDistance(t *testing.T) {
+	pairs := [][2]*UndirectedGraph{
+		{CycleGraph(4), PathGraph(4)},
+		{StarGraph(5), PathGraph(5)},
+		{twoTriangles(), CycleGraph(6)},
+		{diamondGraph(), CompleteGraph(4)},
+		{NullGraph(), PathGraph(3)},
+	}
+	for _, pair := range pairs {
+		exact, _, _ := GraphEditDistance(pair[0], pair[1], nil, time.Minute)
+		approximate, mapping := ApproximateGraphEditDistance(pair[0], pair[1], nil)
+		if approximate < exact {
+			t.Errorf("Approximation %v is below the exact distance %v", approximate, exact)
+		}
+		if cost := editPathCost(pair[0], pair[1], nil, mapping); cost != approximate {
+			t.Errorf("Mapping %v costs %v instead of %v", mapping, cost, approximate)
+		}
+	}
+
+	// Distinct degrees make the local edge costs pick the optimal assignment
+	distance, mapping := ApproximateGraphEditDistance(StarGraph(4), StarGraph(5), nil)
+	if distance != 2 || mapping[0] != 0 {
+		t.Errorf("Expected distance 2 with the centres matched, but got %v with %v", distance, mapping)
+	}
+}
+
+func TestHungarianAssignment(t *testing.T) {
+	cost := [][]float64{
+		{4, 1, 3},
+		{2, 0, 5},
+		{3, 2, 2},
+	}
+	assignment := hungarianAssignment(cost)
+	if assignment[0] != 1 || assignment[1] != 0 || assignment[2] != 2 {
+		t.Errorf("Expected assignment [1 0 2], but got %v", assignment)
+	}
+}
+
+func TestSpectralDistance(t *testing.T) {
+	// K3 has adjacency eigenvalues 2, -1, -1 and Laplacian eigenvalues 3, 3, 0
+	empty := NullGraph()
+	for node := Node(0); node < 3; node++ {
+		empty.AddNode(node)
+	}
+	if distance := SpectralDistance(CompleteGraph(3), empty, false); math.Abs(distance-math.Sqrt(6)) > 1e-9 {
+		t.Errorf("Expected adjacency distance √6, but got %v", distance)
+	}
+	if distance := SpectralDistance(CompleteGraph(3), empty, true); math.Abs(distance-math.Sqrt(18)) > 1e-9 {
+		t.Errorf("Expected Laplacian distance √18, but got %v", distance)
+	}
+	// Padding with zeros matches the isolated nodes
+	if distance := SpectralDistance(CompleteGraph(3), NullGraph(), false); math.Abs(distance-math.Sqrt(6)) > 1e-9 {
+		t.Errorf("Expected distance √6 to the null graph, but got %v", distance)
+	}
+
+	relabelled, _ := RelabelNodes(LadderGraph(4), map[Node]Node{0: 5, 1: 7, 2: 6, 3: 4, 4: 3, 5: 0, 6: 2, 7: 1}, false)
+	if distance := SpectralDistance(LadderGraph(4), relabelled, true); distance > 1e-9 {
+		t.Errorf("Expected distance 0 between isomorphic graphs, but got %v", distance)
+	}
+	if distance := SpectralDistance(CycleGraph(6), twoTriangles(), false); distance < 1e-3 {
+		t.Errorf("Expected a positive distance, but got %v", distance)
+	}
+}
+
+func TestDegreeDistributionDistance(t *testing.T) {
+	tests := []struct {
+		name     string
+		g1       *UndirectedGraph
+		g2       *UndirectedGraph
+		expected float64
+	}{
+		{"SameShape", CycleGraph(5), CycleGraph(50), 0},
+		{"StarAndCycle", StarGraph(4), CycleGraph(4), 0.75},
+		{"PathAndCycle", PathGraph(4), CycleGraph(8), 0.5},
+		{"BothEmpty", NullGraph(), NullGraph(), 0},
+		{"OneEmpty", NullGraph(), CycleGraph(3), 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			distance := DegreeDistributionDistance(test.g1, test.g2)
+			if math.Abs(distance-test.expected) > 1e-12 {
+				t.Errorf("Expected %v, but got %v", test.expected, distance)
+			}
+			if reverse := DegreeDistributionDistance(test.g2, test.g1); reverse != distance {
+				t.Errorf("Distance is not symmetric: %v and %v", distance, reverse)
+			}
+		})
+	}
+}
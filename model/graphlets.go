@@ -0,0 +1,339 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+// Graphlet identifies a connected graph on three or four nodes, numbered as in Pržulj's graphlet papers.
+type Graphlet int
+
+const (
+	PathGraphlet3          Graphlet = iota + 1 // G1: path on three nodes
+	TriangleGraphlet                           // G2: triangle
+	PathGraphlet4                              // G3: path on four nodes
+	StarGraphlet                               // G4: star with three leaves
+	CycleGraphlet4                             // G5: cycle on four nodes
+	TailedTriangleGraphlet                     // G6: triangle with a pendant node (paw)
+	DiamondGraphlet                            // G7: cycle on four nodes with one chord
+	CompleteGraphlet4                          // G8: complete graph on four nodes
+)
+
+// Graphlets lists every graphlet type in increasing order.
+var Graphlets = []Graphlet{
+	PathGraphlet3, TriangleGraphlet, PathGraphlet4, StarGraphlet,
+	CycleGraphlet4, TailedTriangleGraphlet, DiamondGraphlet, CompleteGraphlet4,
+}
+
+func (graphlet Graphlet) String() string {
+	names := map[Graphlet]string{
+		PathGraphlet3:          "path3",
+		TriangleGraphlet:       "triangle",
+		PathGraphlet4:          "path4",
+		StarGraphlet:           "star",
+		CycleGraphlet4:         "cycle4",
+		TailedTriangleGraphlet: "tailed-triangle",
+		DiamondGraphlet:        "diamond",
+		CompleteGraphlet4:      "complete4",
+	}
+	if name, ok := names[graphlet]; ok {
+		return name
+	}
+	return fmt.Sprintf("Graphlet(%d)", int(graphlet))
+}
+
+/*
+GraphletDegreeVector counts, for a node, how many times it touches each of the 15 automorphism orbits of the
+graphlets on two to four nodes.
+
+Description:
+Orbit 0 is the degree. The remaining orbits follow Pržulj's numbering:
+- 1, 2: end and middle of a path on three nodes.
+- 3: node of a triangle.
+- 4, 5: end and inner node of a path on four nodes.
+- 6, 7: leaf and centre of a star.
+- 8: node of a cycle on four nodes.
+- 9, 10, 11: pendant node, degree two node and degree three node of a tailed triangle.
+- 12, 13: degree two and degree three nodes of a diamond.
+- 14: node of a complete graph on four nodes.
+*/
+type GraphletDegreeVector [15]int
+
+// classifyGraphlet returns the graphlet induced by nodes, and the degree of every node inside it.
+func classifyGraphlet(neighbours map[Node]map[Node]bool, nodes []Node) (Graphlet, []int) {
+	degrees := make([]int, len(nodes))
+	edges, maxDegree := 0, 0
+	for i := range nodes {
+		for j := i + 1; j < len(nodes); j++ {
+			if neighbours[nodes[i]][nodes[j]] {
+				degrees[i]++
+				degrees[j]++
+				edges++
+			}
+		}
+	}
+	for _, degree := range degrees {
+		maxDegree = max(maxDegree, degree)
+	}
+
+	if len(nodes) == 3 {
+		if edges == 3 {
+			return TriangleGraphlet, degrees
+		}
+		return PathGraphlet3, degrees
+	}
+	switch edges {
+	case 3:
+		if maxDegree == 3 {
+			return StarGraphlet, degrees
+		}
+		return PathGraphlet4, degrees
+	case 4:
+		if maxDegree == 3 {
+			return TailedTriangleGraphlet, degrees
+		}
+		return CycleGraphlet4, degrees
+	case 5:
+		return DiamondGraphlet, degrees
+	default:
+		return CompleteGraphlet4, degrees
+	}
+}
+
+// graphletOrbit returns the orbit of a node of the given degree inside a graphlet.
+func graphletOrbit(graphlet Graphlet, degree int) int {
+	switch graphlet {
+	case PathGraphlet3:
+		return degree // 1 for the ends, 2 for the middle
+	case TriangleGraphlet:
+		return 3
+	case PathGraphlet4:
+		return 3 + degree // 4 for the ends, 5 for the inner nodes
+	case StarGraphlet:
+		if degree == 1 {
+			return 6
+		}
+		return 7
+	case CycleGraphlet4:
+		return 8
+	case TailedTriangleGraphlet:
+		return 8 + degree // 9, 10 and 11 for degrees 1, 2 and 3
+	case DiamondGraphlet:
+		return 10 + degree // 12 and 13 for degrees 2 and 3
+	default:
+		return 14
+	}
+}
+
+// connectedInducedSubgraphs calls visit once for every connected set of three or four
+// nodes, using the ESU algorithm of Wernicke. The slice passed to visit is reused.
+func connectedInducedSubgraphs(neighbours map[Node]map[Node]bool, nodes []Node, visit func(subgraph []Node)) {
+	const maxSize = 4
+	subgraph := make([]Node, 0, maxSize)
+	inSubgraph := make(map[Node]bool, maxSize)
+
+	var extend func(extension []Node, root Node)
+	extend = func(extension []Node, root Node) {
+		if len(subgraph) >= 3 {
+			visit(subgraph)
+		}
+		if len(subgraph) == maxSize {
+			return
+		}
+		for len(extension) > 0 {
+			w := extension[len(extension)-1]
+			extension = extension[:len(extension)-1]
+
+			// Exclusive neighbours of w: larger than the root and not adjacent to the current subgraph
+			next := append([]Node(nil), extension...)
+			for u := range neighbours[w] {
+				if u <= root || inSubgraph[u] {
+					continue
+				}
+				exclusive := true
+				for _, member := range subgraph {
+					if neighbours[member][u] {
+						exclusive = false
+						break
+					}
+				}
+				if exclusive {
+					next = append(next, u)
+				}
+			}
+
+			subgraph = append(subgraph, w)
+			inSubgraph[w] = true
+			extend(next, root)
+			delete(inSubgraph, w)
+			subgraph = subgraph[:len(subgraph)-1]
+		}
+	}
+
+	for _, root := range nodes {
+		var extension []Node
+		for u := range neighbours[root] {
+			if u > root {
+				extension = append(extension, u)
+			}
+		}
+		subgraph = append(subgraph[:0], root)
+		inSubgraph[root] = true
+		extend(extension, root)
+		delete(inSubgraph, root)
+	}
+}
+
+/*
+GraphletCounts counts the induced occurrences of every connected graphlet on three and four nodes.
+
+Parameters:
+- g: The undirected graph.
+
+Returns:
+- counts: The number of node sets inducing each graphlet; every graphlet of Graphlets is present, possibly with a zero count.
+
+Description:
+The connected node sets are enumerated with the ESU algorithm, so the running
+time grows with the number of connected subgraphs rather than with all
+4-subsets. Self-loops and parallel edges are ignored.
+
+Example:
+
+	counts := GraphletCounts(CompleteGraph(4))
+	fmt.Println(counts[TriangleGraphlet], counts[CompleteGraphlet4]) // Output: 4 1
+*/
+func GraphletCounts(g *UndirectedGraph) map[Graphlet]int {
+	neighbours := neighbourSets(g)
+	counts := make(map[Graphlet]int, len(Graphlets))
+	for _, graphlet := range Graphlets {
+		counts[graphlet] = 0
+	}
+	connectedInducedSubgraphs(neighbours, sortedNodes(g.Nodes), func(subgraph []Node) {
+		graphlet, _ := classifyGraphlet(neighbours, subgraph)
+		counts[graphlet]++
+	})
+	return counts
+}
+
+/*
+GraphletDegreeVectors returns the graphlet degree vector of every node.
+
+Parameters:
+- g: The undirected graph.
+
+Returns:
+- vectors: For every node, the number of times it touches each orbit; see GraphletDegreeVector.
+
+Description:
+Two nodes with similar vectors have similar local topology, which is used to
+compare the roles of proteins in interaction networks. Self-loops and parallel
+edges are ignored.
+*/
+func GraphletDegreeVectors(g *UndirectedGraph) map[Node]GraphletDegreeVector {
+	neighbours := neighbourSets(g)
+	vectors := make(map[Node]GraphletDegreeVector, len(g.Nodes))
+	for node := range g.Nodes {
+		vector := GraphletDegreeVector{}
+		vector[0] = len(neighbours[node])
+		vectors[node] = vector
+	}
+	connectedInducedSubgraphs(neighbours, sortedNodes(g.Nodes), func(subgraph []Node) {
+		graphlet, degrees := classifyGraphlet(neighbours, subgraph)
+		for i, node := range subgraph {
+			vector := vectors[node]
+			vector[graphletOrbit(graphlet, degrees[i])]++
+			vectors[node] = vector
+		}
+	})
+	return vectors
+}
+
+// GraphletScore compares the count of a graphlet in a graph with its counts in randomised graphs.
+type GraphletScore struct {
+	Observed     int
+	RandomMean   float64
+	RandomStdDev float64
+	// ZScore is (Observed - RandomMean) / RandomStdDev, or 0 when the standard deviation is 0.
+	ZScore float64
+}
+
+/*
+GraphletSignificance compares graphlet counts with those of degree-preserving randomisations of the graph.
+
+Parameters:
+- g: The undirected graph.
+- numberOfRandomGraphs: The number of randomised graphs in the null model.
+- swapsPerEdge: The number of successful double edge swaps per edge used to randomise each graph; at most 100 times as many swaps are attempted.
+- options: Optional source of randomness, e.g. WithSeed(42) for reproducible scores.
+
+Returns:
+- scores: For every graphlet, its observed count together with the mean, standard deviation and z-score of the counts in the null model.
+- error: If numberOfRandomGraphs or swapsPerEdge is not positive.
+
+Description:
+Each randomised graph is obtained from a simple copy of g by DoubleEdgeSwap,
+which keeps every degree unchanged. If the attempts run out, the swaps done so
+far are kept; graphs that admit no swap, such as complete graphs, stars or
+graphs with fewer than four nodes, are their own null model and get z-scores
+of 0. Graphlets with a large positive z-score are over-represented, i.e.
+network motifs.
+*/
+func GraphletSignificance(g *UndirectedGraph, numberOfRandomGraphs int, swapsPerEdge int, options ...RandomOption) (map[Graphlet]GraphletScore, error) {
+	if numberOfRandomGraphs < 1 {
+		return nil, fmt.Errorf("the number of random graphs must be positive, got %d", numberOfRandomGraphs)
+	}
+	if swapsPerEdge < 1 {
+		return nil, fmt.Errorf("the number of swaps per edge must be positive, got %d", swapsPerEdge)
+	}
+
+	random := newRandom(options...)
+	observed := GraphletCounts(g)
+	sums := make(map[Graphlet]float64, len(Graphlets))
+	squares := make(map[Graphlet]float64, len(Graphlets))
+	for i := 0; i < numberOfRandomGraphs; i++ {
+		randomised := simpleCopy(g)
+		swaps := swapsPerEdge * randomised.NumberOfEdges()
+		// The arguments are valid, so an error means that the graph is too small to swap or that the tries ran out,
+		// and in both cases randomised holds the swaps done so far
+		DoubleEdgeSwap(randomised, swaps, 100*swaps, WithRand(random))
+		for graphlet, count := range GraphletCounts(randomised) {
+			sums[graphlet] += float64(count)
+			squares[graphlet] += float64(count) * float64(count)
+		}
+	}
+
+	scores := make(map[Graphlet]GraphletScore, len(Graphlets))
+	for _, graphlet := range Graphlets {
+		mean := sums[graphlet] / float64(numberOfRandomGraphs)
+		variance := math.Max(squares[graphlet]/float64(numberOfRandomGraphs)-mean*mean, 0)
+		score := GraphletScore{
+			Observed:     observed[graphlet],
+			RandomMean:   mean,
+			RandomStdDev: math.Sqrt(variance),
+		}
+		if score.RandomStdDev > 0 {
+			score.ZScore = (float64(score.Observed) - mean) / score.RandomStdDev
+		}
+		scores[graphlet] = score
+	}
+	return scores, nil
+}
+
+// simpleCopy returns a copy of g without self-loops and parallel edges.
+func simpleCopy(g *UndirectedGraph) *UndirectedGraph {
+	copied := &UndirectedGraph{
+		Nodes: make(map[Node]bool, len(g.Nodes)),
+		Edges: make(map[Node][]Node, len(g.Nodes)),
+	}
+	neighbours := neighbourSets(g)
+	for _, node := range sortedNodes(g.Nodes) {
+		copied.AddNode(node)
+		for _, neighbor := range sortedNodes(neighbours[node]) {
+			if node < neighbor {
+				copied.AddEdge(Edge{Node1: node, Node2: neighbor})
+			}
+		}
+	}
+	return copied
+}
@@ -0,0 +1,132 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestGraphletCounts(t *testing.T) {
+	testCases := []struct {
+		name     string
+		graph    *UndirectedGraph
+		expected map[Graphlet]int
+	}{
+		{
+			name:     "Complete graph",
+			graph:    CompleteGraph(4),
+			expected: map[Graphlet]int{TriangleGraphlet: 4, CompleteGraphlet4: 1},
+		},
+		{
+			name:     "Star graph",
+			graph:    StarGraph(5),
+			expected: map[Graphlet]int{PathGraphlet3: 6, StarGraphlet: 4},
+		},
+		{
+			name:     "Cycle graph",
+			graph:    CycleGraph(4),
+			expected: map[Graphlet]int{PathGraphlet3: 4, CycleGraphlet4: 1},
+		},
+		{
+			name:     "Path graph",
+			graph:    PathGraph(5),
+			expected: map[Graphlet]int{PathGraphlet3: 3, PathGraphlet4: 2},
+		},
+		{
+			name:  "Lollipop graph",
+			graph: LollipopGraph(3, 1),
+			expected: map[Graphlet]int{
+				PathGraphlet3: 2, TriangleGraphlet: 1, TailedTriangleGraphlet: 1,
+			},
+		},
+		{
+			name:  "Diamond",
+			graph: diamondGraph(),
+			expected: map[Graphlet]int{
+				PathGraphlet3: 2, TriangleGraphlet: 2, DiamondGraphlet: 1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counts := GraphletCounts(tc.graph)
+			for _, graphlet := range Graphlets {
+				if counts[graphlet] != tc.expected[graphlet] {
+					t.Errorf("Expected %d %v, but got %d", tc.expected[graphlet], graphlet, counts[graphlet])
+				}
+			}
+		})
+	}
+}
+
+// diamondGraph returns a 4-cycle 0 - 1 - 2 - 3 with the chord 0 - 2.
+func diamondGraph() *UndirectedGraph {
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {0, 2}})
+	return g
+}
+
+func TestGraphletDegreeVectors(t *testing.T) {
+	vectors := GraphletDegreeVectors(LollipopGraph(3, 1))
+	expected := map[Node]GraphletDegreeVector{
+		// Triangle nodes 0 and 1, shared node 2 and pendant node 3
+		0: {2, 1, 0, 1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0},
+		1: {2, 1, 0, 1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0},
+		2: {3, 0, 2, 1, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0},
+		3: {1, 2, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0},
+	}
+	for node, vector := range expected {
+		if vectors[node] != vector {
+			t.Errorf("Expected %v for node %d, but got %v", vector, node, vectors[node])
+		}
+	}
+
+	star := GraphletDegreeVectors(StarGraph(4))
+	if star[0][7] != 1 || star[1][6] != 1 || star[0][2] != 3 {
+		t.Errorf("Unexpected star vectors %v", star)
+	}
+}
+
+func TestGraphletSignificance(t *testing.T) {
+	// Rewiring a cycle keeps every node at degree 2, so the number of paths of
+	// length two, induced or closed into a triangle, stays 12
+	g := CycleGraph(12)
+	scores, err := GraphletSignificance(g, 5, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if scores[PathGraphlet3].Observed != 12 || scores[TriangleGraphlet].Observed != 0 {
+		t.Errorf("Unexpected observed counts %+v", scores)
+	}
+	if wedges := scores[PathGraphlet3].RandomMean + 3*scores[TriangleGraphlet].RandomMean; wedges < 11.999 || wedges > 12.001 {
+		t.Errorf("Expected 12 paths of length two on average, got %v", wedges)
+	}
+
+	// Two cliques joined by an edge are full of triangles compared with their rewirings
+	cliques := CompleteGraph(6)
+	for i := 6; i < 12; i++ {
+		for j := i + 1; j < 12; j++ {
+			cliques.AddEdge(Edge{Node1: Node(i), Node2: Node(j)})
+		}
+	}
+	cliques.AddEdge(Edge{Node1: 0, Node2: 6})
+	scores, _ = GraphletSignificance(cliques, 10, 5)
+	if float64(scores[TriangleGraphlet].Observed) < scores[TriangleGraphlet].RandomMean {
+		t.Errorf("Expected triangles to be over-represented, got %+v", scores[TriangleGraphlet])
+	}
+
+	if _, err := GraphletSignificance(g, 0, 1); err == nil {
+		t.Errorf("Expected an error without random graphs")
+	}
+	// Complete graphs, stars and tiny graphs admit no swap, so they are their own null model
+	for _, unswappable := range []*UndirectedGraph{CompleteGraph(5), StarGraph(5), PathGraph(3)} {
+		scores, err := GraphletSignificance(unswappable, 3, 1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for graphlet, score := range scores {
+			if score.RandomMean != float64(score.Observed) || score.RandomStdDev != 0 || score.ZScore != 0 {
+				t.Errorf("Expected the observed count of %v without variation, but got %+v", graphlet, score)
+			}
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// hamiltonianSearch runs the backtracking search shared by HamiltonianPath and HamiltonianCycle.
+func hamiltonianSearch(g *UndirectedGraph, cycle bool, timeout time.Duration) ([]Node, error) {
+	nodes, _, adjacency := simpleAdjacency(g)
+	n := len(nodes)
+	if n == 0 {
+		return nil, nil
+	}
+	if cycle && n < 3 {
+		// A single node is not a cycle and two nodes can only be joined by a single simple edge
+		return nil, nil
+	}
+	if len(ConnectedComponents(g).ComponentsArray) != 1 {
+		return nil, nil
+	}
+
+	deadline := time.Now().Add(timeout)
+	steps := 0
+	timedOut := false
+	visited := make([]bool, n)
+	path := make([]int, 0, n)
+
+	// Visit neighbours with fewer unvisited neighbours first (Warnsdorff's rule)
+	freeDegree := func(v int) int {
+		count := 0
+		for _, w := range adjacency[v] {
+			if !visited[w] {
+				count++
+			}
+		}
+		return count
+	}
+
+	var extend func(v int) bool
+	extend = func(v int) bool {
+		steps++
+		if steps%1024 == 0 && timeout > 0 && time.Now().After(deadline) {
+			timedOut = true
+		}
+		if timedOut {
+			return false
+		}
+		if len(path) == n {
+			if !cycle {
+				return true
+			}
+			for _, w := range adjacency[v] {
+				if w == path[0] {
+					return true
+				}
+			}
+			return false
+		}
+
+		candidates := make([]int, 0, len(adjacency[v]))
+		for _, w := range adjacency[v] {
+			if !visited[w] {
+				candidates = append(candidates, w)
+			}
+		}
+		sort.SliceStable(candidates, func(i, j int) bool { return freeDegree(candidates[i]) < freeDegree(candidates[j]) })
+		for _, w := range candidates {
+			visited[w] = true
+			path = append(path, w)
+			if extend(w) {
+				return true
+			}
+			path = path[:len(path)-1]
+			visited[w] = false
+			if timedOut {
+				return false
+			}
+		}
+		return false
+	}
+
+	// A cycle goes through every node, so starting from the first one is enough
+	starts := []int{0}
+	if !cycle {
+		starts = make([]int, n)
+		for v := range starts {
+			starts[v] = v
+		}
+		// Endpoints of a path are more likely among low degree nodes
+		sort.SliceStable(starts, func(i, j int) bool { return len(adjacency[starts[i]]) < len(adjacency[starts[j]]) })
+	}
+	for _, start := range starts {
+		visited[start] = true
+		path = append(path[:0], start)
+		if extend(start) {
+			result := make([]Node, n)
+			for i, v := range path {
+				result[i] = nodes[v]
+			}
+			return result, nil
+		}
+		visited[start] = false
+		if timedOut {
+			return nil, fmt.Errorf("hamiltonian search timed out after %v", timeout)
+		}
+	}
+	return nil, nil
+}
+
+/*
+HamiltonianPath searches for a path visiting every node of the graph exactly once.
+
+Parameters:
+- g: The undirected graph.
+- timeout: The maximum duration of the search; zero or negative means no limit.
+
+Returns:
+- path: The nodes in visiting order, or nil if the graph has no Hamiltonian path.
+- error: If the search was interrupted by the timeout before reaching an answer.
+
+Description:
+The problem is NP-complete: the function runs an exhaustive backtracking search
+which visits neighbours with the fewest unvisited neighbours first, and is only
+practical for small graphs. Self-loops and parallel edges are ignored.
+
+Example:
+
+	path, _ := HamiltonianPath(PathGraph(4), time.Second)
+	fmt.Println(path) // Output: [0 1 2 3]
+*/
+func HamiltonianPath(g *UndirectedGraph, timeout time.Duration) ([]Node, error) {
+	return hamiltonianSearch(g, false, timeout)
+}
+
+/*
+HamiltonianCycle searches for a cycle visiting every node of the graph exactly once.
+
+Parameters:
+- g: The undirected graph.
+- timeout: The maximum duration of the search; zero or negative means no limit.
+
+Returns:
+- cycle: The nodes in visiting order starting from the smallest node, the closing node not repeated; nil if the graph has no Hamiltonian cycle.
+- error: If the search was interrupted by the timeout before reaching an answer.
+
+Description:
+See HamiltonianPath. Graphs with fewer than three nodes have no Hamiltonian cycle.
+*/
+func HamiltonianCycle(g *UndirectedGraph, timeout time.Duration) ([]Node, error) {
+	return hamiltonianSearch(g, true, timeout)
+}
+
+// IsHamiltonianPath reports whether path visits every node of the graph exactly once along edges of the graph.
+func IsHamiltonianPath(g *UndirectedGraph, path []Node) bool {
+	if len(path) != len(g.Nodes) {
+		return false
+	}
+	seen := make(map[Node]bool, len(path))
+	for i, node := range path {
+		if !g.HasNode(node) || seen[node] {
+			return false
+		}
+		seen[node] = true
+		if i > 0 && !g.HasEdge(Edge{Node1: path[i-1], Node2: node}) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsHamiltonianCycle reports whether cycle is a Hamiltonian path whose last node is adjacent to the first.
+func IsHamiltonianCycle(g *UndirectedGraph, cycle []Node) bool {
+	return len(cycle) >= 3 && IsHamiltonianPath(g, cycle) && g.HasEdge(Edge{Node1: cycle[len(cycle)-1], Node2: cycle[0]})
+}
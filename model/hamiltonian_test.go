@@ -0,0 +1,83 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHamiltonianPath(t *testing.T) {
+	testCases := []struct {
+		name   string
+		graph  *UndirectedGraph
+		exists bool
+	}{
+		{name: "Path graph", graph: PathGraph(6), exists: true},
+		{name: "Lollipop graph", graph: LollipopGraph(4, 3), exists: true},
+		{name: "Star graph", graph: StarGraph(4), exists: false},
+		{name: "Trivial graph", graph: TrivialGraph(), exists: true},
+		{name: "Complete bipartite graph", graph: CompleteBipartiteGraph(2, 4), exists: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := HamiltonianPath(tc.graph, time.Second)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tc.exists != (path != nil) {
+				t.Fatalf("Expected a path to exist: %v, but got %v", tc.exists, path)
+			}
+			if tc.exists && !IsHamiltonianPath(tc.graph, path) {
+				t.Errorf("%v is not a Hamiltonian path", path)
+			}
+		})
+	}
+}
+
+func TestHamiltonianCycle(t *testing.T) {
+	testCases := []struct {
+		name   string
+		graph  *UndirectedGraph
+		exists bool
+	}{
+		{name: "Cycle graph", graph: CycleGraph(7), exists: true},
+		{name: "Complete graph", graph: CompleteGraph(6), exists: true},
+		{name: "Circulant graph", graph: CirculantGraph(8, 3), exists: true},
+		{name: "Circular ladder", graph: circularLadder(5), exists: true},
+		{name: "Lollipop graph", graph: LollipopGraph(4, 2), exists: false},
+		{name: "Path graph", graph: PathGraph(4), exists: false},
+		{name: "Complete bipartite graph", graph: CompleteBipartiteGraph(3, 4), exists: false},
+		{name: "Single edge", graph: PathGraph(2), exists: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cycle, err := HamiltonianCycle(tc.graph, time.Second)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tc.exists != (cycle != nil) {
+				t.Fatalf("Expected a cycle to exist: %v, but got %v", tc.exists, cycle)
+			}
+			if tc.exists && !IsHamiltonianCycle(tc.graph, cycle) {
+				t.Errorf("%v is not a Hamiltonian cycle", cycle)
+			}
+		})
+	}
+}
+
+func TestHamiltonianCycle_Timeout(t *testing.T) {
+	// Two K8 joined by a bridge have no Hamiltonian cycle, and proving it
+	// requires exploring many permutations
+	g := CompleteGraph(8)
+	for i := 8; i < 16; i++ {
+		for j := i + 1; j < 16; j++ {
+			g.AddEdge(Edge{Node1: Node(i), Node2: Node(j)})
+		}
+	}
+	g.AddEdge(Edge{Node1: 0, Node2: 8})
+
+	if _, err := HamiltonianCycle(g, time.Nanosecond); err == nil {
+		t.Errorf("Expected the search to time out")
+	}
+}
@@ -0,0 +1,468 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// NodeMatchFunc reports whether node1 of the first graph may be mapped to node2 of the second one.
+// It typically compares attributes kept by the caller, e.g. in a map[Node]string.
+type NodeMatchFunc func(node1 Node, node2 Node) bool
+
+// EdgeMatchFunc reports whether edge1 of the first graph may be mapped to edge2 of the second one.
+type EdgeMatchFunc func(edge1 Edge, edge2 Edge) bool
+
+type matchMode int
+
+const (
+	isomorphismMode matchMode = iota
+	inducedSubgraphMode
+	monomorphismMode
+)
+
+/*
+GraphMatcher checks graph isomorphism and subgraph isomorphism with the VF2 algorithm.
+
+Fields:
+- G1: The first (target) graph.
+- G2: The second (pattern) graph.
+- NodeMatch: Optional semantic check on node pairs; nil accepts every pair.
+- EdgeMatch: Optional semantic check on edge pairs; nil accepts every pair.
+
+Description:
+Mappings go from nodes of G1 to nodes of G2, following the VF2 paper of
+Cordella, Foggia, Sansone and Vento. Subgraph isomorphisms map an induced
+subgraph of G1 onto G2, while subgraph monomorphisms only require every edge
+of G2 to be present in G1, which is what motif search usually needs. Parallel
+edges and self-loops are matched by multiplicity.
+
+Example:
+
+	matcher := NewGraphMatcher(CycleGraph(5), PathGraph(3), nil, nil)
+	fmt.Println(matcher.IsSubgraphIsomorphic()) // Output: true
+*/
+type GraphMatcher struct {
+	G1        *UndirectedGraph
+	G2        *UndirectedGraph
+	NodeMatch NodeMatchFunc
+	EdgeMatch EdgeMatchFunc
+}
+
+// NewGraphMatcher returns a matcher of g2 against g1 using the given (possibly nil) match functions.
+func NewGraphMatcher(g1 *UndirectedGraph, g2 *UndirectedGraph, nodeMatch NodeMatchFunc, edgeMatch EdgeMatchFunc) *GraphMatcher {
+	return &GraphMatcher{G1: g1, G2: g2, NodeMatch: nodeMatch, EdgeMatch: edgeMatch}
+}
+
+// IsIsomorphic reports whether G1 and G2 are isomorphic.
+func (m *GraphMatcher) IsIsomorphic() bool {
+	found := false
+	m.Isomorphisms()(func(map[Node]Node) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// IsSubgraphIsomorphic reports whether an induced subgraph of G1 is isomorphic to G2.
+func (m *GraphMatcher) IsSubgraphIsomorphic() bool {
+	found := false
+	m.SubgraphIsomorphisms()(func(map[Node]Node) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// IsSubgraphMonomorphic reports whether a subgraph of G1, not necessarily induced, is isomorphic to G2.
+func (m *GraphMatcher) IsSubgraphMonomorphic() bool {
+	found := false
+	m.SubgraphMonomorphisms()(func(map[Node]Node) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// Isomorphisms returns an iterator, following the iter.Seq convention, over the isomorphisms from G1 to G2.
+func (m *GraphMatcher) Isomorphisms() func(yield func(map[Node]Node) bool) {
+	return func(yield func(map[Node]Node) bool) {
+		if len(m.G1.Nodes) != len(m.G2.Nodes) || !CouldBeIsomorphic(m.G1, m.G2) {
+			return
+		}
+		newVF2State(m, isomorphismMode).match(yield)
+	}
+}
+
+// SubgraphIsomorphisms returns an iterator, following the iter.Seq convention, over the isomorphisms
+// from induced subgraphs of G1 to G2.
+func (m *GraphMatcher) SubgraphIsomorphisms() func(yield func(map[Node]Node) bool) {
+	return func(yield func(map[Node]Node) bool) {
+		if len(m.G1.Nodes) < len(m.G2.Nodes) {
+			return
+		}
+		newVF2State(m, inducedSubgraphMode).match(yield)
+	}
+}
+
+// SubgraphMonomorphisms returns an iterator, following the iter.Seq convention, over the injective
+// mappings from nodes of G1 to nodes of G2 under which every edge of G2 is an edge of G1.
+func (m *GraphMatcher) SubgraphMonomorphisms() func(yield func(map[Node]Node) bool) {
+	return func(yield func(map[Node]Node) bool) {
+		if len(m.G1.Nodes) < len(m.G2.Nodes) {
+			return
+		}
+		newVF2State(m, monomorphismMode).match(yield)
+	}
+}
+
+// vf2State holds the partial mapping explored by the VF2 recursion.
+type vf2State struct {
+	matcher       *GraphMatcher
+	mode          matchMode
+	adjacency1    map[Node]map[Node]int
+	adjacency2    map[Node]map[Node]int
+	nodes1        []Node
+	nodes2        []Node
+	core1, core2  map[Node]Node
+	inout1        map[Node]int
+	inout2        map[Node]int
+	stopRequested bool
+}
+
+// edgeMultiplicities counts the edges between every pair of adjacent nodes. A self-loop counts twice.
+func edgeMultiplicities(g *UndirectedGraph) map[Node]map[Node]int {
+	multiplicities := make(map[Node]map[Node]int, len(g.Nodes))
+	for node := range g.Nodes {
+		multiplicities[node] = make(map[Node]int)
+		for _, neighbor := range g.Edges[node] {
+			multiplicities[node][neighbor]++
+		}
+	}
+	return multiplicities
+}
+
+func newVF2State(m *GraphMatcher, mode matchMode) *vf2State {
+	return &vf2State{
+		matcher:    m,
+		mode:       mode,
+		adjacency1: edgeMultiplicities(m.G1),
+		adjacency2: edgeMultiplicities(m.G2),
+		nodes1:     sortedNodes(m.G1.Nodes),
+		nodes2:     sortedNodes(m.G2.Nodes),
+		core1:      make(map[Node]Node),
+		core2:      make(map[Node]Node),
+		inout1:     make(map[Node]int),
+		inout2:     make(map[Node]int),
+	}
+}
+
+// candidatePairs returns the pairs that may extend the current mapping.
+func (s *vf2State) candidatePairs() [][2]Node {
+	var terminal1 []Node
+	for _, node := range s.nodes1 {
+		if _, in := s.inout1[node]; in {
+			if _, mapped := s.core1[node]; !mapped {
+				terminal1 = append(terminal1, node)
+			}
+		}
+	}
+	terminal2, found2 := Node(0), false
+	for _, node := range s.nodes2 {
+		if _, in := s.inout2[node]; in {
+			if _, mapped := s.core2[node]; !mapped {
+				terminal2, found2 = node, true
+				break
+			}
+		}
+	}
+
+	var pairs [][2]Node
+	if len(terminal1) > 0 && found2 {
+		for _, node := range terminal1 {
+			pairs = append(pairs, [2]Node{node, terminal2})
+		}
+		return pairs
+	}
+
+	for _, other := range s.nodes2 {
+		if _, mapped := s.core2[other]; mapped {
+			continue
+		}
+		for _, node := range s.nodes1 {
+			if _, mapped := s.core1[node]; !mapped {
+				pairs = append(pairs, [2]Node{node, other})
+			}
+		}
+		break
+	}
+	return pairs
+}
+
+// feasible checks the syntactic and semantic feasibility of mapping node1 to node2.
+func (s *vf2State) feasible(node1 Node, node2 Node) bool {
+	loops1, loops2 := s.adjacency1[node1][node1], s.adjacency2[node2][node2]
+	if s.mode == monomorphismMode {
+		if loops1 < loops2 {
+			return false
+		}
+	} else if loops1 != loops2 {
+		return false
+	}
+
+	// Edges towards already mapped nodes must be preserved
+	if s.mode != monomorphismMode {
+		for neighbor, count := range s.adjacency1[node1] {
+			if image, mapped := s.core1[neighbor]; mapped && neighbor != node1 {
+				if s.adjacency2[node2][image] != count {
+					return false
+				}
+			}
+		}
+	}
+	for neighbor, count := range s.adjacency2[node2] {
+		if preimage, mapped := s.core2[neighbor]; mapped && neighbor != node2 {
+			available := s.adjacency1[node1][preimage]
+			if (s.mode == monomorphismMode && available < count) || (s.mode != monomorphismMode && available != count) {
+				return false
+			}
+		}
+	}
+
+	// Look-ahead on the terminal sets and the unexplored nodes
+	if s.mode != monomorphismMode {
+		terminal1, new1 := s.lookAhead(s.adjacency1[node1], s.core1, s.inout1, node1)
+		terminal2, new2 := s.lookAhead(s.adjacency2[node2], s.core2, s.inout2, node2)
+		if s.mode == isomorphismMode && (terminal1 != terminal2 || new1 != new2) {
+			return false
+		}
+		if s.mode == inducedSubgraphMode && (terminal1 < terminal2 || new1 < new2) {
+			return false
+		}
+	}
+
+	// Semantic feasibility
+	m := s.matcher
+	if m.NodeMatch != nil && !m.NodeMatch(node1, node2) {
+		return false
+	}
+	if m.EdgeMatch != nil {
+		if loops2 > 0 && !m.EdgeMatch(Edge{Node1: node1, Node2: node1}, Edge{Node1: node2, Node2: node2}) {
+			return false
+		}
+		for neighbor := range s.adjacency2[node2] {
+			if preimage, mapped := s.core2[neighbor]; mapped && neighbor != node2 {
+				if !m.EdgeMatch(Edge{Node1: node1, Node2: preimage}, Edge{Node1: node2, Node2: neighbor}) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// lookAhead counts the neighbours of node lying in the terminal set and those not yet reached.
+func (s *vf2State) lookAhead(neighbours map[Node]int, core map[Node]Node, inout map[Node]int, node Node) (int, int) {
+	terminal, unexplored := 0, 0
+	for neighbor := range neighbours {
+		if neighbor == node {
+			continue
+		}
+		if _, mapped := core[neighbor]; mapped {
+			continue
+		}
+		if _, in := inout[neighbor]; in {
+			terminal++
+		} else {
+			unexplored++
+		}
+	}
+	return terminal, unexplored
+}
+
+// match extends the current mapping recursively and yields every complete one.
+func (s *vf2State) match(yield func(map[Node]Node) bool) {
+	if len(s.core2) == len(s.nodes2) {
+		mapping := make(map[Node]Node, len(s.core1))
+		for node1, node2 := range s.core1 {
+			mapping[node1] = node2
+		}
+		s.stopRequested = !yield(mapping)
+		return
+	}
+
+	for _, pair := range s.candidatePairs() {
+		node1, node2 := pair[0], pair[1]
+		if !s.feasible(node1, node2) {
+			continue
+		}
+
+		depth := len(s.core1) + 1
+		s.core1[node1], s.core2[node2] = node2, node1
+		added1 := s.enterTerminal(s.inout1, s.adjacency1, node1, depth)
+		added2 := s.enterTerminal(s.inout2, s.adjacency2, node2, depth)
+
+		s.match(yield)
+
+		for _, node := range added1 {
+			delete(s.inout1, node)
+		}
+		for _, node := range added2 {
+			delete(s.inout2, node)
+		}
+		delete(s.core1, node1)
+		delete(s.core2, node2)
+		if s.stopRequested {
+			return
+		}
+	}
+}
+
+// enterTerminal adds node and its neighbours to the terminal set and returns the nodes it added.
+func (s *vf2State) enterTerminal(inout map[Node]int, adjacency map[Node]map[Node]int, node Node, depth int) []Node {
+	var added []Node
+	if _, in := inout[node]; !in {
+		inout[node] = depth
+		added = append(added, node)
+	}
+	for neighbor := range adjacency[node] {
+		if _, in := inout[neighbor]; !in {
+			inout[neighbor] = depth
+			added = append(added, neighbor)
+		}
+	}
+	return added
+}
+
+/*
+IsIsomorphic reports whether two graphs are isomorphic.
+
+Parameters:
+- g1, g2: The graphs to compare.
+- nodeMatch, edgeMatch: Optional semantic checks on node and edge pairs; nil accepts every pair.
+
+Description:
+Graphs whose invariants differ are rejected by CouldBeIsomorphic before the
+VF2 search runs.
+
+Example:
+
+	fmt.Println(IsIsomorphic(CycleGraph(4), LadderGraph(2), nil, nil)) // Output: true
+*/
+func IsIsomorphic(g1 *UndirectedGraph, g2 *UndirectedGraph, nodeMatch NodeMatchFunc, edgeMatch EdgeMatchFunc) bool {
+	return NewGraphMatcher(g1, g2, nodeMatch, edgeMatch).IsIsomorphic()
+}
+
+// Triangles returns, for every node, the number of triangles it belongs to. Self-loops and parallel edges are ignored.
+func Triangles(g *UndirectedGraph) map[Node]int {
+	neighbours := neighbourSets(g)
+	triangles := make(map[Node]int, len(g.Nodes))
+	for node, adjacent := range neighbours {
+		count := 0
+		for u := range adjacent {
+			for v := range adjacent {
+				if u < v && neighbours[u][v] {
+					count++
+				}
+			}
+		}
+		triangles[node] = count
+	}
+	return triangles
+}
+
+/*
+CouldBeIsomorphic performs cheap necessary checks for isomorphism.
+
+Returns:
+- false if the graphs certainly are not isomorphic: their node counts, edge counts, sorted degree sequences or sorted sequences of (degree, triangles) pairs differ. True does not guarantee isomorphism.
+*/
+func CouldBeIsomorphic(g1 *UndirectedGraph, g2 *UndirectedGraph) bool {
+	if len(g1.Nodes) != len(g2.Nodes) || g1.NumberOfEdges() != g2.NumberOfEdges() {
+		return false
+	}
+	invariants := func(g *UndirectedGraph) [][2]int {
+		triangles := Triangles(g)
+		pairs := make([][2]int, 0, len(g.Nodes))
+		for node := range g.Nodes {
+			pairs = append(pairs, [2]int{g.NodeDegree(node), triangles[node]})
+		}
+		sort.Slice(pairs, func(i, j int) bool {
+			if pairs[i][0] != pairs[j][0] {
+				return pairs[i][0] < pairs[j][0]
+			}
+			return pairs[i][1] < pairs[j][1]
+		})
+		return pairs
+	}
+	pairs1, pairs2 := invariants(g1), invariants(g2)
+	for i := range pairs1 {
+		if pairs1[i] != pairs2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// labelHash returns the hex encoded hash of a label, truncated to 16 bytes.
+func labelHash(label string) string {
+	sum := sha256.Sum256([]byte(label))
+	return hex.EncodeToString(sum[:16])
+}
+
+/*
+WeisfeilerLehmanGraphHash returns the Weisfeiler-Lehman hash of the graph.
+
+Parameters:
+- g: The undirected graph.
+- iterations: The number of neighbourhood aggregation rounds, i.e. the radius of the subtrees taken into account.
+- nodeLabels: Optional initial node labels; when nil, the degree of each node is used.
+
+Returns:
+- A hex string. Isomorphic graphs (with matching labels) always get the same hash; non-isomorphic graphs get different hashes with high probability, although some pairs, e.g. regular graphs of the same degree and size, cannot be told apart.
+
+Description:
+At each round the label of every node is replaced by the hash of its label and
+the sorted labels of its neighbours. The graph hash is computed from the
+histogram of all labels over all rounds, as in the networkx implementation.
+
+Example:
+
+	hash1 := WeisfeilerLehmanGraphHash(CycleGraph(4), 3, nil)
+	hash2 := WeisfeilerLehmanGraphHash(LadderGraph(2), 3, nil)
+	fmt.Println(hash1 == hash2) // Output: true
+*/
+func WeisfeilerLehmanGraphHash(g *UndirectedGraph, iterations int, nodeLabels map[Node]string) string {
+	labels := make(map[Node]string, len(g.Nodes))
+	for node := range g.Nodes {
+		if nodeLabels != nil {
+			labels[node] = nodeLabels[node]
+		} else {
+			labels[node] = fmt.Sprint(g.NodeDegree(node))
+		}
+	}
+
+	histogram := make(map[string]int)
+	for i := 0; i < iterations; i++ {
+		relabeled := make(map[Node]string, len(labels))
+		for node := range g.Nodes {
+			neighbourLabels := make([]string, 0, len(g.Edges[node]))
+			for _, neighbor := range g.Edges[node] {
+				neighbourLabels = append(neighbourLabels, labels[neighbor])
+			}
+			sort.Strings(neighbourLabels)
+			relabeled[node] = labelHash(labels[node] + "(" + strings.Join(neighbourLabels, ",") + ")")
+			histogram[relabeled[node]]++
+		}
+		labels = relabeled
+	}
+
+	entries := make([]string, 0, len(histogram))
+	for label, count := range histogram {
+		entries = append(entries, fmt.Sprintf("%s:%d", label, count))
+	}
+	sort.Strings(entries)
+	return labelHash(strings.Join(entries, ";"))
+}
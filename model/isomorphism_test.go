@@ -0,0 +1,177 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestIsIsomorphic(t *testing.T) {
+	shuffled, _ := RelabelNodes(LollipopGraph(4, 3), map[Node]Node{0: 6, 1: 4, 2: 5, 3: 0, 4: 2, 5: 1, 6: 3}, false)
+	testCases := []struct {
+		name     string
+		g1       *UndirectedGraph
+		g2       *UndirectedGraph
+		expected bool
+	}{
+		{name: "Square and ladder", g1: CycleGraph(4), g2: LadderGraph(2), expected: true},
+		{name: "Relabeled lollipop", g1: LollipopGraph(4, 3), g2: shuffled, expected: true},
+		{name: "Different sizes", g1: CycleGraph(4), g2: CycleGraph(5), expected: false},
+		{name: "Prism and utility graph", g1: circularLadder(3), g2: CompleteBipartiteGraph(3, 3), expected: false},
+		{name: "Octagon and two squares", g1: CycleGraph(8), g2: twoSquares(), expected: false},
+		{name: "Two triangles and a hexagon", g1: twoTriangles(), g2: CycleGraph(6), expected: false},
+		{name: "Star and path", g1: StarGraph(4), g2: PathGraph(4), expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if IsIsomorphic(tc.g1, tc.g2, nil, nil) != tc.expected {
+				t.Errorf("Expected IsIsomorphic to be %v", tc.expected)
+			}
+		})
+	}
+}
+
+// twoTriangles returns two disjoint triangles, which are 2-regular like a 6-cycle.
+func twoTriangles() *UndirectedGraph {
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}})
+	return g
+}
+
+// twoSquares returns two disjoint 4-cycles, which share every invariant checked by CouldBeIsomorphic with an 8-cycle.
+func twoSquares() *UndirectedGraph {
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {4, 5}, {5, 6}, {6, 7}, {7, 4}})
+	return g
+}
+
+func TestGraphMatcher_Isomorphisms(t *testing.T) {
+	// The automorphisms of a cycle form the dihedral group
+	count := 0
+	NewGraphMatcher(CycleGraph(5), CycleGraph(5), nil, nil).Isomorphisms()(func(mapping map[Node]Node) bool {
+		count++
+		return true
+	})
+	if count != 10 {
+		t.Errorf("Expected 10 automorphisms of C5, but got %d", count)
+	}
+
+	count = 0
+	NewGraphMatcher(CompleteGraph(4), CompleteGraph(4), nil, nil).Isomorphisms()(func(mapping map[Node]Node) bool {
+		count++
+		return count < 5
+	})
+	if count != 5 {
+		t.Errorf("Expected the enumeration to stop after 5 mappings, but got %d", count)
+	}
+}
+
+func TestGraphMatcher_MatchFunctions(t *testing.T) {
+	colors1 := map[Node]string{0: "red", 1: "blue", 2: "blue"}
+	colors2 := map[Node]string{0: "blue", 1: "blue", 2: "red"}
+	colors3 := map[Node]string{0: "blue", 1: "red", 2: "blue"}
+	nodeMatch := func(colors2 map[Node]string) NodeMatchFunc {
+		return func(node1 Node, node2 Node) bool { return colors1[node1] == colors2[node2] }
+	}
+	if !IsIsomorphic(PathGraph(3), PathGraph(3), nodeMatch(colors2), nil) {
+		t.Errorf("Expected the coloured paths to be isomorphic")
+	}
+	if IsIsomorphic(PathGraph(3), PathGraph(3), nodeMatch(colors3), nil) {
+		t.Errorf("Expected the coloured paths not to be isomorphic")
+	}
+
+	// Heavy edge 0 - 1 in the first triangle must map onto heavy edge 1 - 2 in the second
+	weights1 := map[Edge]float64{{Node1: 0, Node2: 1}: 5}
+	weights2 := map[Edge]float64{{Node1: 1, Node2: 2}: 5}
+	edgeMatch := func(edge1 Edge, edge2 Edge) bool {
+		return undirectedEdgeWeight(weights1, edge1) == undirectedEdgeWeight(weights2, edge2)
+	}
+	count := 0
+	NewGraphMatcher(CycleGraph(3), CycleGraph(3), nil, edgeMatch).Isomorphisms()(func(mapping map[Node]Node) bool {
+		count++
+		if mapping[2] != 0 {
+			t.Errorf("Node 2 must be mapped to node 0, got %v", mapping)
+		}
+		return true
+	})
+	if count != 2 {
+		t.Errorf("Expected 2 weight preserving isomorphisms, but got %d", count)
+	}
+}
+
+func TestGraphMatcher_Subgraphs(t *testing.T) {
+	testCases := []struct {
+		name       string
+		g1         *UndirectedGraph
+		g2         *UndirectedGraph
+		induced    int
+		monomorphs int
+	}{
+		{name: "Path in a cycle", g1: CycleGraph(5), g2: PathGraph(3), induced: 10, monomorphs: 10},
+		{name: "Path in a triangle", g1: CycleGraph(3), g2: PathGraph(3), induced: 0, monomorphs: 6},
+		{name: "Triangle in K4", g1: CompleteGraph(4), g2: CycleGraph(3), induced: 24, monomorphs: 24},
+		{name: "Square in a ladder", g1: LadderGraph(3), g2: CycleGraph(4), induced: 16, monomorphs: 16},
+		{name: "Pattern larger than target", g1: PathGraph(2), g2: PathGraph(3), induced: 0, monomorphs: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matcher := NewGraphMatcher(tc.g1, tc.g2, nil, nil)
+			induced, monomorphs := 0, 0
+			matcher.SubgraphIsomorphisms()(func(mapping map[Node]Node) bool {
+				induced++
+				return true
+			})
+			matcher.SubgraphMonomorphisms()(func(mapping map[Node]Node) bool {
+				monomorphs++
+				for node1, node2 := range mapping {
+					for _, neighbor := range tc.g2.Edges[node2] {
+						found := false
+						for preimage, image := range mapping {
+							if image == neighbor && tc.g1.HasEdge(Edge{Node1: node1, Node2: preimage}) {
+								found = true
+							}
+						}
+						if !found {
+							t.Errorf("Mapping %v does not preserve the edges of the pattern", mapping)
+						}
+					}
+				}
+				return true
+			})
+			if induced != tc.induced || monomorphs != tc.monomorphs {
+				t.Errorf("Expected %d induced and %d monomorphisms, but got %d and %d", tc.induced, tc.monomorphs, induced, monomorphs)
+			}
+			if matcher.IsSubgraphIsomorphic() != (tc.induced > 0) || matcher.IsSubgraphMonomorphic() != (tc.monomorphs > 0) {
+				t.Errorf("Predicates disagree with the enumeration")
+			}
+		})
+	}
+}
+
+func TestCouldBeIsomorphic(t *testing.T) {
+	if CouldBeIsomorphic(twoTriangles(), CycleGraph(6)) {
+		t.Errorf("Expected triangle counts to tell the graphs apart")
+	}
+	if !CouldBeIsomorphic(CycleGraph(4), LadderGraph(2)) {
+		t.Errorf("Expected isomorphic graphs to pass the invariant checks")
+	}
+	if triangles := Triangles(LollipopGraph(4, 1)); triangles[0] != 3 || triangles[4] != 0 {
+		t.Errorf("Unexpected triangle counts %v", triangles)
+	}
+}
+
+func TestWeisfeilerLehmanGraphHash(t *testing.T) {
+	shuffled, _ := RelabelNodes(LollipopGraph(4, 3), map[Node]Node{0: 6, 1: 4, 2: 5, 3: 0, 4: 2, 5: 1, 6: 3}, false)
+	if WeisfeilerLehmanGraphHash(LollipopGraph(4, 3), 3, nil) != WeisfeilerLehmanGraphHash(shuffled, 3, nil) {
+		t.Errorf("Expected isomorphic graphs to share their hash")
+	}
+	if WeisfeilerLehmanGraphHash(StarGraph(4), 3, nil) == WeisfeilerLehmanGraphHash(PathGraph(4), 3, nil) {
+		t.Errorf("Expected a star and a path to have different hashes")
+	}
+
+	labels1 := map[Node]string{0: "a", 1: "b", 2: "a"}
+	labels2 := map[Node]string{0: "b", 1: "a", 2: "a"}
+	if WeisfeilerLehmanGraphHash(PathGraph(3), 2, labels1) == WeisfeilerLehmanGraphHash(PathGraph(3), 2, labels2) {
+		t.Errorf("Expected node labels to change the hash")
+	}
+}
@@ -0,0 +1,334 @@
+package model
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"sort"
+)
+
+type ILinkPredictor interface {
+	// Scorer prepares the predictor for the graph and returns a function scoring a pair of nodes.
+	Scorer(graph *UndirectedGraph) (func(node1 Node, node2 Node) float64, error)
+}
+
+/*
+LINK PREDICTION INDICES
+*/
+type CommonNeighboursPredictor struct{ ILinkPredictor }
+type JaccardPredictor struct{ ILinkPredictor }
+type AdamicAdarPredictor struct{ ILinkPredictor }
+type ResourceAllocationPredictor struct{ ILinkPredictor }
+type PreferentialAttachmentPredictor struct{ ILinkPredictor }
+
+// KatzPredictor scores a pair by the number of walks between the two nodes, a walk of length l weighing Beta^l.
+// Walks longer than MaxPathLength are ignored. Zero values default to Beta = 0.005 and MaxPathLength = 5.
+type KatzPredictor struct {
+	Beta          float64
+	MaxPathLength int
+}
+
+// LinkScore is the score of a candidate edge.
+type LinkScore struct {
+	Edge  Edge
+	Score float64
+}
+
+// Scorer counts the common neighbours |N(u) ∩ N(v)|.
+func (predictor *CommonNeighboursPredictor) Scorer(g *UndirectedGraph) (func(Node, Node) float64, error) {
+	neighbours := neighbourSets(g)
+	return func(node1 Node, node2 Node) float64 {
+		return float64(commonNeighbourCount(neighbours, node1, node2))
+	}, nil
+}
+
+// Scorer computes the Jaccard coefficient |N(u) ∩ N(v)| / |N(u) ∪ N(v)|, or 0 when both neighbourhoods are empty.
+func (predictor *JaccardPredictor) Scorer(g *UndirectedGraph) (func(Node, Node) float64, error) {
+	neighbours := neighbourSets(g)
+	return func(node1 Node, node2 Node) float64 {
+		common := commonNeighbourCount(neighbours, node1, node2)
+		union := len(neighbours[node1]) + len(neighbours[node2]) - common
+		if union == 0 {
+			return 0
+		}
+		return float64(common) / float64(union)
+	}, nil
+}
+
+// Scorer sums 1 / log(deg(w)) over the common neighbours w.
+func (predictor *AdamicAdarPredictor) Scorer(g *UndirectedGraph) (func(Node, Node) float64, error) {
+	neighbours := neighbourSets(g)
+	return func(node1 Node, node2 Node) float64 {
+		score := 0.0
+		for _, neighbor := range sortedCommonNeighbours(neighbours, node1, node2) {
+			// A common neighbour of two distinct nodes has degree at least 2, so the logarithm is positive
+			score += 1 / math.Log(float64(len(neighbours[neighbor])))
+		}
+		return score
+	}, nil
+}
+
+// Scorer sums 1 / deg(w) over the common neighbours w.
+func (predictor *ResourceAllocationPredictor) Scorer(g *UndirectedGraph) (func(Node, Node) float64, error) {
+	neighbours := neighbourSets(g)
+	return func(node1 Node, node2 Node) float64 {
+		score := 0.0
+		for _, neighbor := range sortedCommonNeighbours(neighbours, node1, node2) {
+			score += 1 / float64(len(neighbours[neighbor]))
+		}
+		return score
+	}, nil
+}
+
+// Scorer multiplies the degrees deg(u)·deg(v).
+func (predictor *PreferentialAttachmentPredictor) Scorer(g *UndirectedGraph) (func(Node, Node) float64, error) {
+	neighbours := neighbourSets(g)
+	return func(node1 Node, node2 Node) float64 {
+		return float64(len(neighbours[node1]) * len(neighbours[node2]))
+	}, nil
+}
+
+// Scorer computes the truncated Katz index Σ Beta^l·(number of walks of length l from u to v).
+// The walk counts from a node are computed on first use, in O(MaxPathLength·E), and cached.
+func (predictor *KatzPredictor) Scorer(g *UndirectedGraph) (func(Node, Node) float64, error) {
+	beta, maxPathLength := predictor.Beta, predictor.MaxPathLength
+	if beta == 0 {
+		beta = 0.005
+	}
+	if maxPathLength == 0 {
+		maxPathLength = 5
+	}
+	if beta < 0 || maxPathLength < 0 {
+		return nil, fmt.Errorf("katz parameters must be positive, got beta %v and path length %d", beta, maxPathLength)
+	}
+
+	neighbours := neighbourSets(g)
+	cache := make(map[Node]map[Node]float64)
+	return func(node1 Node, node2 Node) float64 {
+		scores, ok := cache[node1]
+		if !ok {
+			scores = make(map[Node]float64)
+			walks := map[Node]float64{node1: 1}
+			weight := 1.0
+			for length := 1; length <= maxPathLength; length++ {
+				weight *= beta
+				next := make(map[Node]float64, len(walks))
+				for node, count := range walks {
+					for neighbor := range neighbours[node] {
+						next[neighbor] += count
+					}
+				}
+				for node, count := range next {
+					scores[node] += weight * count
+				}
+				walks = next
+			}
+			cache[node1] = scores
+		}
+		return scores[node2]
+	}, nil
+}
+
+// commonNeighbourCount returns |N(u) ∩ N(v)|.
+func commonNeighbourCount(neighbours map[Node]map[Node]bool, node1 Node, node2 Node) int {
+	small, large := neighbours[node1], neighbours[node2]
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	count := 0
+	for neighbor := range small {
+		if large[neighbor] {
+			count++
+		}
+	}
+	return count
+}
+
+// sortedCommonNeighbours returns N(u) ∩ N(v) in increasing order, so that floating point sums over the common
+// neighbours don't depend on the map iteration order and equal scores compare equal.
+func sortedCommonNeighbours(neighbours map[Node]map[Node]bool, node1 Node, node2 Node) []Node {
+	small, large := neighbours[node1], neighbours[node2]
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	var common []Node
+	for neighbor := range small {
+		if large[neighbor] {
+			common = append(common, neighbor)
+		}
+	}
+	sort.Slice(common, func(i, j int) bool { return common[i] < common[j] })
+	return common
+}
+
+// forEachNonEdge calls visit on every pair u < v of distinct, non-adjacent nodes, in increasing order,
+// until visit returns false.
+func forEachNonEdge(g *UndirectedGraph, neighbours map[Node]map[Node]bool, visit func(Edge) bool) {
+	nodes := sortedNodes(g.Nodes)
+	for i, u := range nodes {
+		for _, v := range nodes[i+1:] {
+			if !neighbours[u][v] && !visit(Edge{Node1: u, Node2: v}) {
+				return
+			}
+		}
+	}
+}
+
+// nonEdges returns every pair u < v of distinct, non-adjacent nodes.
+func nonEdges(g *UndirectedGraph) []Edge {
+	var candidates []Edge
+	forEachNonEdge(g, neighbourSets(g), func(edge Edge) bool {
+		candidates = append(candidates, edge)
+		return true
+	})
+	return candidates
+}
+
+// forEachTwoHopNonEdge calls visit on every pair u < v of non-adjacent nodes with a common neighbour. Only the
+// two-hop neighbourhood of one node is held in memory at a time.
+func forEachTwoHopNonEdge(g *UndirectedGraph, neighbours map[Node]map[Node]bool, visit func(Edge)) {
+	for _, u := range sortedNodes(g.Nodes) {
+		twoHops := make(map[Node]bool)
+		for middle := range neighbours[u] {
+			for v := range neighbours[middle] {
+				if v > u && !neighbours[u][v] {
+					twoHops[v] = true
+				}
+			}
+		}
+		for v := range twoHops {
+			visit(Edge{Node1: u, Node2: v})
+		}
+	}
+}
+
+// worseLinkScore reports whether a ranks below b: it has a lower score, or the same score and a larger edge.
+func worseLinkScore(a LinkScore, b LinkScore) bool {
+	if a.Score != b.Score {
+		return a.Score < b.Score
+	}
+	if a.Edge.Node1 != b.Edge.Node1 {
+		return a.Edge.Node1 > b.Edge.Node1
+	}
+	return a.Edge.Node2 > b.Edge.Node2
+}
+
+// linkScoreHeap is a min-heap of link scores implementing heap.Interface; its root is the worst score kept.
+type linkScoreHeap []LinkScore
+
+func (h linkScoreHeap) Len() int           { return len(h) }
+func (h linkScoreHeap) Less(i, j int) bool { return worseLinkScore(h[i], h[j]) }
+func (h linkScoreHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *linkScoreHeap) Push(x any) { *h = append(*h, x.(LinkScore)) }
+
+func (h *linkScoreHeap) Pop() any {
+	old := *h
+	score := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return score
+}
+
+/*
+ScoreLinks scores candidate edges with a link prediction index.
+
+Parameters:
+- g: The undirected graph.
+- predictor: The index, e.g. &AdamicAdarPredictor{} or &KatzPredictor{Beta: 0.01}.
+- candidates: The pairs of nodes to score; if nil, every non-edge of the graph is scored.
+
+Returns:
+- scores: One LinkScore per candidate, in the order of the candidates (non-edges are listed with Node1 < Node2 in increasing order).
+- error: If a candidate contains a node that is not in the graph or pairs a node with itself, or the predictor rejects its parameters.
+
+Example:
+
+	g := PathGraph(3)
+	scores, _ := ScoreLinks(g, &CommonNeighboursPredictor{}, nil)
+	fmt.Println(scores) // Output: [{{0 2} 1}]
+*/
+func ScoreLinks(g *UndirectedGraph, predictor ILinkPredictor, candidates []Edge) ([]LinkScore, error) {
+	if candidates == nil {
+		candidates = nonEdges(g)
+	}
+	for _, edge := range candidates {
+		if !g.HasNode(edge.Node1) || !g.HasNode(edge.Node2) {
+			return nil, fmt.Errorf("candidate %v contains a node that is not in the graph", edge)
+		}
+		if edge.Node1 == edge.Node2 {
+			return nil, fmt.Errorf("candidate %v pairs a node with itself", edge)
+		}
+	}
+	score, err := predictor.Scorer(g)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make([]LinkScore, len(candidates))
+	for i, edge := range candidates {
+		scores[i] = LinkScore{Edge: edge, Score: score(edge.Node1, edge.Node2)}
+	}
+	return scores, nil
+}
+
+/*
+TopKLinks returns the k highest scoring non-edges of the graph.
+
+Parameters:
+- g: The undirected graph.
+- predictor: The link prediction index.
+- k: The number of predictions; fewer are returned when the graph has fewer non-edges.
+
+Returns:
+- scores: The predictions by decreasing score; ties are broken by increasing edge.
+- error: If k is negative or the predictor rejects its parameters.
+
+Description:
+The best k scores are kept in a min-heap, so only O(k) scores are held in
+memory. The neighbourhood indices (common neighbours, Jaccard, Adamic-Adar and
+resource allocation) are zero unless the two nodes have a common neighbour, so
+only the pairs two hops apart are scored, through their middle node; zero
+scoring non-edges are only visited when fewer than k pairs are two hops apart.
+Other indices, such as Katz and preferential attachment, score every non-edge.
+*/
+func TopKLinks(g *UndirectedGraph, predictor ILinkPredictor, k int) ([]LinkScore, error) {
+	if k < 0 {
+		return nil, fmt.Errorf("k must not be negative, got %d", k)
+	}
+	score, err := predictor.Scorer(g)
+	if err != nil {
+		return nil, err
+	}
+
+	neighbours := neighbourSets(g)
+	top := &linkScoreHeap{}
+	offer := func(edge Edge) bool {
+		candidate := LinkScore{Edge: edge, Score: score(edge.Node1, edge.Node2)}
+		if top.Len() < k {
+			heap.Push(top, candidate)
+		} else if k > 0 && worseLinkScore((*top)[0], candidate) {
+			(*top)[0] = candidate
+			heap.Fix(top, 0)
+		}
+		return true
+	}
+	switch predictor.(type) {
+	case *CommonNeighboursPredictor, *JaccardPredictor, *AdamicAdarPredictor, *ResourceAllocationPredictor:
+		forEachTwoHopNonEdge(g, neighbours, func(edge Edge) { offer(edge) })
+		if top.Len() < k {
+			// The remaining non-edges all score 0, so the smallest ones fill the remaining places
+			forEachNonEdge(g, neighbours, func(edge Edge) bool {
+				if commonNeighbourCount(neighbours, edge.Node1, edge.Node2) == 0 {
+					heap.Push(top, LinkScore{Edge: edge})
+				}
+				return top.Len() < k
+			})
+		}
+	default:
+		forEachNonEdge(g, neighbours, offer)
+	}
+
+	scores := []LinkScore(*top)
+	sort.Slice(scores, func(i, j int) bool { return worseLinkScore(scores[j], scores[i]) })
+	return scores, nil
+}
@@ -0,0 +1,131 @@
+package model
+
+import (
+	"math"
+	"sort"
+	"testing"
+)
+
+func TestLinkPredictors(t *testing.T) {
+	// 0 and 3 share neighbours 1 and 2; node 1 also links to 4
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}, {1, 4}})
+	candidate := Edge{Node1: 0, Node2: 3}
+
+	testCases := []struct {
+		name      string
+		predictor ILinkPredictor
+		expected  float64
+	}{
+		{name: "Common neighbours", predictor: &CommonNeighboursPredictor{}, expected: 2},
+		{name: "Jaccard", predictor: &JaccardPredictor{}, expected: 1},
+		{name: "Adamic-Adar", predictor: &AdamicAdarPredictor{}, expected: 1/math.Log(3) + 1/math.Log(2)},
+		{name: "Resource allocation", predictor: &ResourceAllocationPredictor{}, expected: 1.0/3 + 1.0/2},
+		{name: "Preferential attachment", predictor: &PreferentialAttachmentPredictor{}, expected: 4},
+		// Two walks of length 2 and nine of length 4, such as 0-1-4-1-3; the graph is bipartite so there are no odd walks
+		{name: "Katz", predictor: &KatzPredictor{Beta: 0.1, MaxPathLength: 4}, expected: 2*0.01 + 9*0.0001},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scores, err := ScoreLinks(g, tc.predictor, []Edge{candidate})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(scores) != 1 || math.Abs(scores[0].Score-tc.expected) > 1e-12 {
+				t.Errorf("Expected %v, but got %v", tc.expected, scores)
+			}
+		})
+	}
+}
+
+func TestScoreLinks_AllNonEdges(t *testing.T) {
+	scores, err := ScoreLinks(PathGraph(4), &CommonNeighboursPredictor{}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []LinkScore{
+		{Edge: Edge{Node1: 0, Node2: 2}, Score: 1},
+		{Edge: Edge{Node1: 0, Node2: 3}, Score: 0},
+		{Edge: Edge{Node1: 1, Node2: 3}, Score: 1},
+	}
+	if len(scores) != len(expected) {
+		t.Fatalf("Expected %v, but got %v", expected, scores)
+	}
+	for i := range expected {
+		if scores[i] != expected[i] {
+			t.Errorf("Expected %v, but got %v", expected[i], scores[i])
+		}
+	}
+
+	if _, err := ScoreLinks(PathGraph(4), &JaccardPredictor{}, []Edge{{Node1: 0, Node2: 9}}); err == nil {
+		t.Errorf("Expected an error for a missing node")
+	}
+	// Degree-1 common neighbours of a node with itself would make Adamic-Adar divide by log(1)
+	if _, err := ScoreLinks(PathGraph(3), &AdamicAdarPredictor{}, []Edge{{Node1: 1, Node2: 1}}); err == nil {
+		t.Errorf("Expected an error for a self-pair")
+	}
+	if _, err := ScoreLinks(PathGraph(4), &KatzPredictor{Beta: -1}, nil); err == nil {
+		t.Errorf("Expected an error for a negative beta")
+	}
+}
+
+func TestTopKLinks(t *testing.T) {
+	g := LollipopGraph(4, 3)
+	top, err := TopKLinks(g, &ResourceAllocationPredictor{}, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// Path nodes of degree 2 are better intermediaries than the clique node 3 of degree 4
+	expected := []Edge{{Node1: 3, Node2: 5}, {Node1: 4, Node2: 6}}
+	if len(top) != 2 || top[0].Edge != expected[0] || top[1].Edge != expected[1] {
+		t.Errorf("Expected %v, but got %v", expected, top)
+	}
+
+	all, _ := TopKLinks(g, &PreferentialAttachmentPredictor{}, 100)
+	if len(all) != 21-g.NumberOfEdges() {
+		t.Errorf("Expected every non-edge, but got %d", len(all))
+	}
+	for i := 1; i < len(all); i++ {
+		if all[i].Score > all[i-1].Score {
+			t.Errorf("Scores are not sorted: %v", all)
+		}
+	}
+	if _, err := TopKLinks(g, &JaccardPredictor{}, -1); err == nil {
+		t.Errorf("Expected an error for a negative k")
+	}
+}
+
+func TestTopKLinks_MatchesFullSort(t *testing.T) {
+	karate, _ := KarateClubGraph()
+	predictors := map[string]ILinkPredictor{
+		"Common neighbours":       &CommonNeighboursPredictor{},
+		"Jaccard":                 &JaccardPredictor{},
+		"Adamic-Adar":             &AdamicAdarPredictor{},
+		"Resource allocation":     &ResourceAllocationPredictor{},
+		"Preferential attachment": &PreferentialAttachmentPredictor{},
+		"Katz":                    &KatzPredictor{Beta: 0.05, MaxPathLength: 3},
+	}
+	for name, predictor := range predictors {
+		t.Run(name, func(t *testing.T) {
+			all, _ := ScoreLinks(karate, predictor, nil)
+			sort.SliceStable(all, func(i, j int) bool { return all[i].Score > all[j].Score })
+			// 561 - 78 = 483 non-edges, most of which are more than two hops apart
+			for _, k := range []int{0, 1, 10, 200, 483, 500} {
+				top, err := TopKLinks(karate, predictor, k)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				expected := all[:min(k, len(all))]
+				if len(top) != len(expected) {
+					t.Fatalf("Expected %d predictions for k = %d, but got %d", len(expected), k, len(top))
+				}
+				for i := range expected {
+					if top[i].Edge != expected[i].Edge || math.Abs(top[i].Score-expected[i].Score) > 1e-12 {
+						t.Fatalf("Expected %v at position %d for k = %d, but got %v", expected[i], i, k, top[i])
+					}
+				}
+			}
+		})
+	}
+}
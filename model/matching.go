@@ -0,0 +1,908 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// simpleAdjacency indexes the nodes of g in increasing order and returns, for
+// every index, the sorted indices of its distinct neighbours. Self-loops and
+// parallel edges are dropped.
+func simpleAdjacency(g *UndirectedGraph) (nodes []Node, index map[Node]int, adjacency [][]int) {
+	nodes = sortedNodes(g.Nodes)
+	index = make(map[Node]int, len(nodes))
+	for idx, node := range nodes {
+		index[node] = idx
+	}
+	adjacency = make([][]int, len(nodes))
+	for idx, node := range nodes {
+		seen := make(map[int]bool)
+		for _, neighbor := range g.Edges[node] {
+			other, ok := index[neighbor]
+			if !ok || other == idx || seen[other] {
+				continue
+			}
+			seen[other] = true
+			adjacency[idx] = append(adjacency[idx], other)
+		}
+		sort.Ints(adjacency[idx])
+	}
+	return nodes, index, adjacency
+}
+
+// matchingFromMates converts a mate array into a sorted list of edges with Node1 < Node2.
+func matchingFromMates(nodes []Node, mate []int) []Edge {
+	matching := make([]Edge, 0)
+	for v, w := range mate {
+		if w > v {
+			matching = append(matching, Edge{Node1: nodes[v], Node2: nodes[w]})
+		}
+	}
+	return matching
+}
+
+/*
+MaxCardinalityMatching returns a maximum cardinality matching of a general graph.
+
+Parameters:
+- g: The undirected graph.
+
+Returns:
+- matching: The matched edges, each with Node1 < Node2, sorted by Node1.
+
+Description:
+The function implements Edmonds' blossom algorithm. Starting from a greedy
+matching, it grows an alternating tree from every exposed node; odd cycles
+(blossoms) are contracted on the fly so that augmenting paths are found in
+non-bipartite graphs too. It runs in O(V^3) time.
+
+Example:
+
+	matching := MaxCardinalityMatching(CycleGraph(5))
+	fmt.Println(len(matching)) // Output: 2
+*/
+func MaxCardinalityMatching(g *UndirectedGraph) []Edge {
+	nodes, _, adjacency := simpleAdjacency(g)
+	n := len(nodes)
+
+	mate := make([]int, n)
+	for i := range mate {
+		mate[i] = -1
+	}
+	// Greedy initialisation saves most of the augmentations on sparse graphs
+	for v := 0; v < n; v++ {
+		if mate[v] >= 0 {
+			continue
+		}
+		for _, w := range adjacency[v] {
+			if mate[w] < 0 {
+				mate[v], mate[w] = w, v
+				break
+			}
+		}
+	}
+
+	parent := make([]int, n)
+	base := make([]int, n)
+	used := make([]bool, n)
+	inBlossom := make([]bool, n)
+
+	lowestCommonAncestor := func(a int, b int) int {
+		onPath := make([]bool, n)
+		for {
+			a = base[a]
+			onPath[a] = true
+			if mate[a] < 0 {
+				break
+			}
+			a = parent[mate[a]]
+		}
+		for {
+			b = base[b]
+			if onPath[b] {
+				return b
+			}
+			b = parent[mate[b]]
+		}
+	}
+
+	markPath := func(v int, blossomBase int, child int) {
+		for base[v] != blossomBase {
+			inBlossom[base[v]] = true
+			inBlossom[base[mate[v]]] = true
+			parent[v] = child
+			child = mate[v]
+			v = parent[mate[v]]
+		}
+	}
+
+	findAugmentingPath := func(root int) int {
+		for i := 0; i < n; i++ {
+			used[i] = false
+			parent[i] = -1
+			base[i] = i
+		}
+		used[root] = true
+		queue := []int{root}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			for _, to := range adjacency[v] {
+				if base[v] == base[to] || mate[v] == to {
+					continue
+				}
+				if to == root || (mate[to] >= 0 && parent[mate[to]] >= 0) {
+					// Found an odd cycle: contract the blossom
+					blossomBase := lowestCommonAncestor(v, to)
+					for i := range inBlossom {
+						inBlossom[i] = false
+					}
+					markPath(v, blossomBase, to)
+					markPath(to, blossomBase, v)
+					for i := 0; i < n; i++ {
+						if inBlossom[base[i]] {
+							base[i] = blossomBase
+							if !used[i] {
+								used[i] = true
+								queue = append(queue, i)
+							}
+						}
+					}
+				} else if parent[to] < 0 {
+					parent[to] = v
+					if mate[to] < 0 {
+						return to
+					}
+					used[mate[to]] = true
+					queue = append(queue, mate[to])
+				}
+			}
+		}
+		return -1
+	}
+
+	for v := 0; v < n; v++ {
+		if mate[v] >= 0 {
+			continue
+		}
+		// Flip the matched and unmatched edges along the augmenting path
+		for to := findAugmentingPath(v); to >= 0; {
+			previous := parent[to]
+			next := mate[previous]
+			mate[to] = previous
+			mate[previous] = to
+			to = next
+		}
+	}
+	return matchingFromMates(nodes, mate)
+}
+
+/*
+HopcroftKarpMatching returns a maximum cardinality matching of a bipartite graph.
+
+Parameters:
+- g: The undirected bipartite graph.
+- left: The nodes of one side of the bipartition. Every other node belongs to the right side.
+
+Returns:
+- matching: The matched edges, each with Node1 on the left side, sorted by Node1.
+- error: If an edge joins two nodes of the same side.
+
+Description:
+Each phase of the Hopcroft-Karp algorithm finds a maximal set of vertex-disjoint
+shortest augmenting paths with a breadth-first search followed by depth-first
+searches, giving O(E sqrt(V)) time.
+*/
+func HopcroftKarpMatching(g *UndirectedGraph, left map[Node]bool) ([]Edge, error) {
+	nodes, _, adjacency := simpleAdjacency(g)
+	n := len(nodes)
+	for v := 0; v < n; v++ {
+		for _, w := range adjacency[v] {
+			if left[nodes[v]] == left[nodes[w]] {
+				return nil, fmt.Errorf("edge %v joins two nodes of the same side", Edge{Node1: nodes[v], Node2: nodes[w]})
+			}
+		}
+	}
+
+	mate := make([]int, n)
+	for i := range mate {
+		mate[i] = -1
+	}
+	distance := make([]int, n)
+	const infinity = math.MaxInt
+
+	bfs := func() bool {
+		var queue []int
+		found := false
+		for v := 0; v < n; v++ {
+			if !left[nodes[v]] {
+				continue
+			}
+			if mate[v] < 0 {
+				distance[v] = 0
+				queue = append(queue, v)
+			} else {
+				distance[v] = infinity
+			}
+		}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			for _, w := range adjacency[v] {
+				next := mate[w]
+				if next < 0 {
+					found = true
+				} else if distance[next] == infinity {
+					distance[next] = distance[v] + 1
+					queue = append(queue, next)
+				}
+			}
+		}
+		return found
+	}
+
+	var dfs func(v int) bool
+	dfs = func(v int) bool {
+		for _, w := range adjacency[v] {
+			next := mate[w]
+			if next < 0 || (distance[next] == distance[v]+1 && dfs(next)) {
+				mate[v], mate[w] = w, v
+				return true
+			}
+		}
+		distance[v] = infinity
+		return false
+	}
+
+	for bfs() {
+		for v := 0; v < n; v++ {
+			if left[nodes[v]] && mate[v] < 0 {
+				dfs(v)
+			}
+		}
+	}
+
+	matching := make([]Edge, 0)
+	for v := 0; v < n; v++ {
+		if left[nodes[v]] && mate[v] >= 0 {
+			matching = append(matching, Edge{Node1: nodes[v], Node2: nodes[mate[v]]})
+		}
+	}
+	return matching, nil
+}
+
+/*
+MaxWeightMatching returns a matching of maximum total weight.
+
+Parameters:
+- g: The undirected graph.
+- weights: Weight of every edge, looked up in either orientation. Edges absent from the map have unit weight.
+- maxCardinality: If true, only maximum cardinality matchings are considered and the heaviest of them is returned.
+
+Returns:
+- matching: The matched edges, each with Node1 < Node2, sorted by Node1.
+
+Description:
+The function implements the O(V^3) primal-dual blossom algorithm of Edmonds and
+Galil, following the formulation of Joris van Rantwijk also used by NetworkX.
+Each stage grows alternating trees from exposed vertices over tight edges,
+contracting and expanding blossoms, and adjusts the dual variables when no
+tight edge is left until an augmenting path is found or the duals prove
+optimality.
+*/
+func MaxWeightMatching(g *UndirectedGraph, weights map[Edge]float64, maxCardinality bool) []Edge {
+	nodes, _, adjacency := simpleAdjacency(g)
+	m := newWeightedMatcher(nodes, adjacency, func(v int, w int) float64 {
+		return undirectedEdgeWeight(weights, Edge{Node1: nodes[v], Node2: nodes[w]})
+	})
+	m.run(maxCardinality)
+	return matchingFromMates(nodes, m.mate)
+}
+
+// weightedMatcher holds the state of the weighted blossom algorithm. Vertices
+// are numbered 0..n-1 and non-trivial blossoms n..2n-1; an edge is a pair of
+// vertices and noEdge marks a missing one.
+type weightedMatcher struct {
+	n         int
+	adjacency [][]int
+	weight    func(v int, w int) float64
+
+	mate          []int
+	label         []int
+	labelEdge     [][2]int
+	inBlossom     []int
+	blossomParent []int
+	blossomBase   []int
+	blossomChilds [][]int
+	blossomEdges  [][][2]int
+	bestEdge      [][2]int
+	myBestEdges   [][][2]int
+	dualVar       []float64
+	allowEdge     map[[2]int]bool
+	unused        []int
+	queue         []int
+}
+
+var noEdge = [2]int{-1, -1}
+
+func newWeightedMatcher(nodes []Node, adjacency [][]int, weight func(v int, w int) float64) *weightedMatcher {
+	n := len(nodes)
+	m := &weightedMatcher{
+		n:             n,
+		adjacency:     adjacency,
+		weight:        weight,
+		mate:          make([]int, n),
+		label:         make([]int, 2*n),
+		labelEdge:     make([][2]int, 2*n),
+		inBlossom:     make([]int, n),
+		blossomParent: make([]int, 2*n),
+		blossomBase:   make([]int, 2*n),
+		blossomChilds: make([][]int, 2*n),
+		blossomEdges:  make([][][2]int, 2*n),
+		bestEdge:      make([][2]int, 2*n),
+		myBestEdges:   make([][][2]int, 2*n),
+		dualVar:       make([]float64, 2*n),
+	}
+
+	maxWeight := 0.0
+	for v := 0; v < n; v++ {
+		for _, w := range adjacency[v] {
+			maxWeight = max(maxWeight, weight(v, w))
+		}
+	}
+	for i := 0; i < 2*n; i++ {
+		m.blossomParent[i] = -1
+		m.bestEdge[i] = noEdge
+		m.labelEdge[i] = noEdge
+		if i < n {
+			m.mate[i] = -1
+			m.inBlossom[i] = i
+			m.blossomBase[i] = i
+			m.dualVar[i] = maxWeight
+		} else {
+			m.blossomBase[i] = -1
+			m.unused = append(m.unused, i)
+		}
+	}
+	return m
+}
+
+// slack returns the reduced cost of edge v - w; tight edges have zero slack.
+func (m *weightedMatcher) slack(v int, w int) float64 {
+	return m.dualVar[v] + m.dualVar[w] - 2*m.weight(v, w)
+}
+
+// leaves returns the vertices contained in blossom b.
+func (m *weightedMatcher) leaves(b int) []int {
+	if b < m.n {
+		return []int{b}
+	}
+	var result []int
+	for _, child := range m.blossomChilds[b] {
+		result = append(result, m.leaves(child)...)
+	}
+	return result
+}
+
+// cyclicIndex maps a possibly negative position onto a blossom's child list.
+func cyclicIndex(j int, length int) int {
+	return ((j % length) + length) % length
+}
+
+func (m *weightedMatcher) setAllowed(v int, w int) {
+	m.allowEdge[[2]int{v, w}] = true
+	m.allowEdge[[2]int{w, v}] = true
+}
+
+// assignLabel labels the top-level blossom containing w with t (1 = S, 2 = T),
+// reached through the edge v - w.
+func (m *weightedMatcher) assignLabel(w int, t int, v int) {
+	b := m.inBlossom[w]
+	m.label[w], m.label[b] = t, t
+	if v >= 0 {
+		m.labelEdge[w], m.labelEdge[b] = [2]int{v, w}, [2]int{v, w}
+	} else {
+		m.labelEdge[w], m.labelEdge[b] = noEdge, noEdge
+	}
+	m.bestEdge[w], m.bestEdge[b] = noEdge, noEdge
+	if t == 1 {
+		m.queue = append(m.queue, m.leaves(b)...)
+	} else {
+		base := m.blossomBase[b]
+		m.assignLabel(m.mate[base], 1, base)
+	}
+}
+
+// scanBlossom traces back from v and w to find either the base of a new
+// blossom or -1 when the two trees are different (an augmenting path).
+func (m *weightedMatcher) scanBlossom(v int, w int) int {
+	var path []int
+	base := -1
+	for v >= 0 {
+		b := m.inBlossom[v]
+		if m.label[b]&4 != 0 {
+			base = m.blossomBase[b]
+			break
+		}
+		path = append(path, b)
+		m.label[b] = 5
+		if m.labelEdge[b] == noEdge {
+			v = -1
+		} else {
+			v = m.labelEdge[b][0]
+			b = m.inBlossom[v]
+			v = m.labelEdge[b][0]
+		}
+		if w >= 0 {
+			v, w = w, v
+		}
+	}
+	for _, b := range path {
+		m.label[b] = 1
+	}
+	return base
+}
+
+// addBlossom contracts the odd cycle closed by edge v - w into a new blossom with the given base.
+func (m *weightedMatcher) addBlossom(base int, v int, w int) {
+	bb := m.inBlossom[base]
+	bv := m.inBlossom[v]
+	bw := m.inBlossom[w]
+	b := m.unused[len(m.unused)-1]
+	m.unused = m.unused[:len(m.unused)-1]
+	m.blossomBase[b] = base
+	m.blossomParent[b] = -1
+	m.blossomParent[bb] = b
+
+	path := []int{}
+	edges := [][2]int{{v, w}}
+	for bv != bb {
+		m.blossomParent[bv] = b
+		path = append(path, bv)
+		edges = append(edges, m.labelEdge[bv])
+		v = m.labelEdge[bv][0]
+		bv = m.inBlossom[v]
+	}
+	path = append(path, bb)
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	for i, j := 0, len(edges)-1; i < j; i, j = i+1, j-1 {
+		edges[i], edges[j] = edges[j], edges[i]
+	}
+	for bw != bb {
+		m.blossomParent[bw] = b
+		path = append(path, bw)
+		edges = append(edges, [2]int{m.labelEdge[bw][1], m.labelEdge[bw][0]})
+		w = m.labelEdge[bw][0]
+		bw = m.inBlossom[w]
+	}
+	m.blossomChilds[b] = path
+	m.blossomEdges[b] = edges
+
+	m.label[b] = 1
+	m.labelEdge[b] = m.labelEdge[bb]
+	m.dualVar[b] = 0
+	for _, leaf := range m.leaves(b) {
+		if m.label[m.inBlossom[leaf]] == 2 {
+			// T-vertices become S-vertices inside the new blossom
+			m.queue = append(m.queue, leaf)
+		}
+		m.inBlossom[leaf] = b
+	}
+
+	// Compute the least-slack edges from the new blossom to neighbouring S-blossoms
+	bestEdgeTo := make(map[int][2]int)
+	for _, child := range path {
+		var candidates [][2]int
+		if child >= m.n && m.myBestEdges[child] != nil {
+			candidates = m.myBestEdges[child]
+			m.myBestEdges[child] = nil
+		} else {
+			for _, leaf := range m.leaves(child) {
+				for _, neighbour := range m.adjacency[leaf] {
+					candidates = append(candidates, [2]int{leaf, neighbour})
+				}
+			}
+		}
+		for _, candidate := range candidates {
+			i, j := candidate[0], candidate[1]
+			if m.inBlossom[j] == b {
+				i, j = j, i
+			}
+			bj := m.inBlossom[j]
+			if bj == b || m.label[bj] != 1 {
+				continue
+			}
+			if current, ok := bestEdgeTo[bj]; !ok || m.slack(i, j) < m.slack(current[0], current[1]) {
+				bestEdgeTo[bj] = [2]int{i, j}
+			}
+		}
+		m.bestEdge[child] = noEdge
+	}
+
+	targets := make([]int, 0, len(bestEdgeTo))
+	for target := range bestEdgeTo {
+		targets = append(targets, target)
+	}
+	sort.Ints(targets)
+	m.myBestEdges[b] = make([][2]int, 0, len(targets))
+	m.bestEdge[b] = noEdge
+	for _, target := range targets {
+		edge := bestEdgeTo[target]
+		m.myBestEdges[b] = append(m.myBestEdges[b], edge)
+		if m.bestEdge[b] == noEdge || m.slack(edge[0], edge[1]) < m.slack(m.bestEdge[b][0], m.bestEdge[b][1]) {
+			m.bestEdge[b] = edge
+		}
+	}
+}
+
+// expandBlossom undoes the contraction of blossom b. When endStage is true,
+// nested blossoms whose dual is zero are expanded recursively.
+func (m *weightedMatcher) expandBlossom(b int, endStage bool) {
+	childs := m.blossomChilds[b]
+	for _, s := range childs {
+		m.blossomParent[s] = -1
+		if s < m.n {
+			m.inBlossom[s] = s
+		} else if endStage && m.dualVar[s] == 0 {
+			m.expandBlossom(s, endStage)
+		} else {
+			for _, leaf := range m.leaves(s) {
+				m.inBlossom[leaf] = s
+			}
+		}
+	}
+
+	if !endStage && m.label[b] == 2 {
+		// The blossom is being expanded during a stage: relabel its children so
+		// that the alternating tree stays consistent.
+		length := len(childs)
+		edges := m.blossomEdges[b]
+		entryChild := m.inBlossom[m.labelEdge[b][1]]
+		j := 0
+		for idx, child := range childs {
+			if child == entryChild {
+				j = idx
+				break
+			}
+		}
+		jStep := -1
+		if j&1 != 0 {
+			j -= length
+			jStep = 1
+		}
+
+		v, w := m.labelEdge[b][0], m.labelEdge[b][1]
+		for j != 0 {
+			var p, q int
+			if jStep == 1 {
+				p, q = edges[cyclicIndex(j, length)][0], edges[cyclicIndex(j, length)][1]
+			} else {
+				q, p = edges[cyclicIndex(j-1, length)][0], edges[cyclicIndex(j-1, length)][1]
+			}
+			m.label[w] = 0
+			m.label[q] = 0
+			m.assignLabel(w, 2, v)
+			m.setAllowed(p, q)
+			j += jStep
+			if jStep == 1 {
+				v, w = edges[cyclicIndex(j, length)][0], edges[cyclicIndex(j, length)][1]
+			} else {
+				w, v = edges[cyclicIndex(j-1, length)][0], edges[cyclicIndex(j-1, length)][1]
+			}
+			m.setAllowed(v, w)
+			j += jStep
+		}
+
+		bw := childs[cyclicIndex(j, length)]
+		m.label[w], m.label[bw] = 2, 2
+		m.labelEdge[w], m.labelEdge[bw] = [2]int{v, w}, [2]int{v, w}
+		m.bestEdge[bw] = noEdge
+		j += jStep
+		for childs[cyclicIndex(j, length)] != entryChild {
+			bv := childs[cyclicIndex(j, length)]
+			if m.label[bv] == 1 {
+				j += jStep
+				continue
+			}
+			reached := -1
+			for _, leaf := range m.leaves(bv) {
+				if m.label[leaf] != 0 {
+					reached = leaf
+					break
+				}
+			}
+			if reached >= 0 {
+				m.label[reached] = 0
+				m.label[m.mate[m.blossomBase[bv]]] = 0
+				m.assignLabel(reached, 2, m.labelEdge[reached][0])
+			}
+			j += jStep
+		}
+	}
+
+	m.label[b] = 0
+	m.labelEdge[b] = noEdge
+	m.bestEdge[b] = noEdge
+	m.blossomChilds[b] = nil
+	m.blossomEdges[b] = nil
+	m.myBestEdges[b] = nil
+	m.blossomBase[b] = -1
+	m.dualVar[b] = 0
+	m.unused = append(m.unused, b)
+}
+
+// augmentBlossom swaps matched and unmatched edges inside blossom b along the
+// path from vertex v to the base, making v the new base.
+func (m *weightedMatcher) augmentBlossom(b int, v int) {
+	t := v
+	for m.blossomParent[t] != b {
+		t = m.blossomParent[t]
+	}
+	if t >= m.n {
+		m.augmentBlossom(t, v)
+	}
+
+	childs := m.blossomChilds[b]
+	edges := m.blossomEdges[b]
+	length := len(childs)
+	i := 0
+	for idx, child := range childs {
+		if child == t {
+			i = idx
+			break
+		}
+	}
+	j := i
+	jStep := -1
+	if i&1 != 0 {
+		j -= length
+		jStep = 1
+	}
+	for j != 0 {
+		j += jStep
+		t = childs[cyclicIndex(j, length)]
+		var w, x int
+		if jStep == 1 {
+			w, x = edges[cyclicIndex(j, length)][0], edges[cyclicIndex(j, length)][1]
+		} else {
+			x, w = edges[cyclicIndex(j-1, length)][0], edges[cyclicIndex(j-1, length)][1]
+		}
+		if t >= m.n {
+			m.augmentBlossom(t, w)
+		}
+		j += jStep
+		t = childs[cyclicIndex(j, length)]
+		if t >= m.n {
+			m.augmentBlossom(t, x)
+		}
+		m.mate[w] = x
+		m.mate[x] = w
+	}
+
+	// Rotate the child list so that the sub-blossom containing v comes first
+	m.blossomChilds[b] = append(append([]int{}, childs[i:]...), childs[:i]...)
+	m.blossomEdges[b] = append(append([][2]int{}, edges[i:]...), edges[:i]...)
+	m.blossomBase[b] = m.blossomBase[m.blossomChilds[b][0]]
+}
+
+// augmentMatching augments the matching along the path through edge v - w
+// connecting the roots of two alternating trees.
+func (m *weightedMatcher) augmentMatching(v int, w int) {
+	for _, pair := range [][2]int{{v, w}, {w, v}} {
+		s, j := pair[0], pair[1]
+		for {
+			bs := m.inBlossom[s]
+			if bs >= m.n {
+				m.augmentBlossom(bs, s)
+			}
+			m.mate[s] = j
+			if m.labelEdge[bs] == noEdge {
+				break
+			}
+			t := m.labelEdge[bs][0]
+			bt := m.inBlossom[t]
+			s, j = m.labelEdge[bt][0], m.labelEdge[bt][1]
+			if bt >= m.n {
+				m.augmentBlossom(bt, j)
+			}
+			m.mate[j] = s
+		}
+	}
+}
+
+func (m *weightedMatcher) run(maxCardinality bool) {
+	n := m.n
+	for {
+		// Start a new stage: every exposed vertex becomes the root of an S-tree
+		for i := 0; i < 2*n; i++ {
+			m.label[i] = 0
+			m.labelEdge[i] = noEdge
+			m.bestEdge[i] = noEdge
+			if i >= n {
+				m.myBestEdges[i] = nil
+			}
+		}
+		m.allowEdge = make(map[[2]int]bool)
+		m.queue = m.queue[:0]
+		for v := 0; v < n; v++ {
+			if m.mate[v] < 0 && m.label[m.inBlossom[v]] == 0 {
+				m.assignLabel(v, 1, -1)
+			}
+		}
+
+		augmented := false
+		for {
+			for len(m.queue) > 0 && !augmented {
+				v := m.queue[len(m.queue)-1]
+				m.queue = m.queue[:len(m.queue)-1]
+				for _, w := range m.adjacency[v] {
+					bv := m.inBlossom[v]
+					bw := m.inBlossom[w]
+					if bv == bw {
+						continue
+					}
+					var kSlack float64
+					if !m.allowEdge[[2]int{v, w}] {
+						kSlack = m.slack(v, w)
+						if kSlack <= 0 {
+							m.setAllowed(v, w)
+						}
+					}
+					if m.allowEdge[[2]int{v, w}] {
+						if m.label[bw] == 0 {
+							m.assignLabel(w, 2, v)
+						} else if m.label[bw] == 1 {
+							if base := m.scanBlossom(v, w); base >= 0 {
+								m.addBlossom(base, v, w)
+							} else {
+								m.augmentMatching(v, w)
+								augmented = true
+								break
+							}
+						} else if m.label[w] == 0 {
+							m.label[w] = 2
+							m.labelEdge[w] = [2]int{v, w}
+						}
+					} else if m.label[bw] == 1 {
+						if m.bestEdge[bv] == noEdge || kSlack < m.slack(m.bestEdge[bv][0], m.bestEdge[bv][1]) {
+							m.bestEdge[bv] = [2]int{v, w}
+						}
+					} else if m.label[w] == 0 {
+						if m.bestEdge[w] == noEdge || kSlack < m.slack(m.bestEdge[w][0], m.bestEdge[w][1]) {
+							m.bestEdge[w] = [2]int{v, w}
+						}
+					}
+				}
+			}
+			if augmented {
+				break
+			}
+
+			// No augmenting path over tight edges: compute the dual adjustment
+			deltaType := -1
+			var delta float64
+			var deltaEdge [2]int
+			deltaBlossom := -1
+			if !maxCardinality {
+				deltaType = 1
+				delta = math.Inf(1)
+				for v := 0; v < n; v++ {
+					delta = min(delta, m.dualVar[v])
+				}
+			}
+			for v := 0; v < n; v++ {
+				if m.label[m.inBlossom[v]] == 0 && m.bestEdge[v] != noEdge {
+					d := m.slack(m.bestEdge[v][0], m.bestEdge[v][1])
+					if deltaType == -1 || d < delta {
+						delta, deltaType, deltaEdge = d, 2, m.bestEdge[v]
+					}
+				}
+			}
+			for b := 0; b < 2*n; b++ {
+				if m.blossomBase[b] >= 0 && m.blossomParent[b] == -1 && m.label[b] == 1 && m.bestEdge[b] != noEdge {
+					d := m.slack(m.bestEdge[b][0], m.bestEdge[b][1]) / 2
+					if deltaType == -1 || d < delta {
+						delta, deltaType, deltaEdge = d, 3, m.bestEdge[b]
+					}
+				}
+			}
+			for b := n; b < 2*n; b++ {
+				if m.blossomBase[b] >= 0 && m.blossomParent[b] == -1 && m.label[b] == 2 && (deltaType == -1 || m.dualVar[b] < delta) {
+					delta, deltaType, deltaBlossom = m.dualVar[b], 4, b
+				}
+			}
+			if deltaType == -1 {
+				// Maximum cardinality reached: make a final dual update for optimality
+				deltaType = 1
+				delta = math.Inf(1)
+				for v := 0; v < n; v++ {
+					delta = min(delta, m.dualVar[v])
+				}
+				delta = max(0, delta)
+			}
+
+			for v := 0; v < n; v++ {
+				switch m.label[m.inBlossom[v]] {
+				case 1:
+					m.dualVar[v] -= delta
+				case 2:
+					m.dualVar[v] += delta
+				}
+			}
+			for b := n; b < 2*n; b++ {
+				if m.blossomBase[b] >= 0 && m.blossomParent[b] == -1 {
+					switch m.label[b] {
+					case 1:
+						m.dualVar[b] += delta
+					case 2:
+						m.dualVar[b] -= delta
+					}
+				}
+			}
+
+			if deltaType == 1 {
+				break
+			} else if deltaType == 2 || deltaType == 3 {
+				v, w := deltaEdge[0], deltaEdge[1]
+				m.setAllowed(v, w)
+				m.queue = append(m.queue, v)
+			} else {
+				m.expandBlossom(deltaBlossom, false)
+			}
+		}
+
+		if !augmented {
+			return
+		}
+		// End of stage: expand S-blossoms whose dual dropped to zero
+		for b := n; b < 2*n; b++ {
+			if m.blossomBase[b] >= 0 && m.blossomParent[b] == -1 && m.label[b] == 1 && m.dualVar[b] == 0 {
+				m.expandBlossom(b, true)
+			}
+		}
+	}
+}
+
+// IsMatching reports whether every edge of matching belongs to g and no two
+// edges share an endpoint.
+func IsMatching(g *UndirectedGraph, matching []Edge) bool {
+	covered := make(map[Node]bool)
+	for _, edge := range matching {
+		if edge.Node1 == edge.Node2 || !g.HasEdge(edge) {
+			return false
+		}
+		if covered[edge.Node1] || covered[edge.Node2] {
+			return false
+		}
+		covered[edge.Node1] = true
+		covered[edge.Node2] = true
+	}
+	return true
+}
+
+// IsMaximalMatching reports whether matching is a matching to which no other
+// edge of g can be added.
+func IsMaximalMatching(g *UndirectedGraph, matching []Edge) bool {
+	if !IsMatching(g, matching) {
+		return false
+	}
+	covered := make(map[Node]bool)
+	for _, edge := range matching {
+		covered[edge.Node1] = true
+		covered[edge.Node2] = true
+	}
+	for _, edge := range g.GetEdgeTuples() {
+		if edge.Node1 != edge.Node2 && !covered[edge.Node1] && !covered[edge.Node2] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsPerfectMatching reports whether matching is a matching covering every node of g.
+func IsPerfectMatching(g *UndirectedGraph, matching []Edge) bool {
+	return IsMatching(g, matching) && 2*len(matching) == len(g.Nodes)
+}
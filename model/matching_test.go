@@ -0,0 +1,129 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMaxCardinalityMatching(t *testing.T) {
+	testCases := []struct {
+		name         string
+		graph        *UndirectedGraph
+		expectedSize int
+		perfect      bool
+	}{
+		{name: "Ladder graph", graph: LadderGraph(4), expectedSize: 4, perfect: true},
+		{name: "Even cycle", graph: CycleGraph(6), expectedSize: 3, perfect: true},
+		{name: "Odd cycle", graph: CycleGraph(7), expectedSize: 3, perfect: false},
+		{name: "Star graph", graph: StarGraph(6), expectedSize: 1, perfect: false},
+		{name: "Complete graph", graph: CompleteGraph(8), expectedSize: 4, perfect: true},
+		{name: "Null graph", graph: NullGraph(), expectedSize: 0, perfect: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matching := MaxCardinalityMatching(tc.graph)
+			if len(matching) != tc.expectedSize {
+				t.Errorf("Expected %d matched edges, but got %d: %v", tc.expectedSize, len(matching), matching)
+			}
+			if !IsMaximalMatching(tc.graph, matching) {
+				t.Errorf("Expected a maximal matching, but got %v", matching)
+			}
+			if IsPerfectMatching(tc.graph, matching) != tc.perfect {
+				t.Errorf("Expected perfect=%v for %v", tc.perfect, matching)
+			}
+		})
+	}
+}
+
+func TestMaxCardinalityMatching_Blossom(t *testing.T) {
+	// A triangle with a pendant path: the greedy matching {0-1} must be
+	// augmented through the blossom 0-1-2 to reach 3 edges.
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {2, 0}, {0, 3}, {2, 4}, {4, 5}})
+
+	matching := MaxCardinalityMatching(g)
+	if len(matching) != 3 || !IsPerfectMatching(g, matching) {
+		t.Errorf("Expected a perfect matching, but got %v", matching)
+	}
+}
+
+func TestHopcroftKarpMatching(t *testing.T) {
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 10}, {0, 11}, {1, 10}, {2, 11}, {2, 12}, {3, 12}})
+	left := map[Node]bool{0: true, 1: true, 2: true, 3: true}
+
+	matching, err := HopcroftKarpMatching(g, left)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(matching) != 3 || !IsMatching(g, matching) {
+		t.Errorf("Expected a matching of size 3, but got %v", matching)
+	}
+	for _, edge := range matching {
+		if !left[edge.Node1] {
+			t.Errorf("Expected Node1 of %v to be on the left side", edge)
+		}
+	}
+
+	if _, err := HopcroftKarpMatching(CycleGraph(3), map[Node]bool{0: true}); err == nil {
+		t.Errorf("Expected an error for a non-bipartite partition")
+	}
+}
+
+func TestMaxWeightMatching(t *testing.T) {
+	// Path 0-1-2-3: the heavy middle edge beats the two outer edges unless
+	// maximum cardinality is required.
+	g := PathGraph(4)
+	weights := map[Edge]float64{{0, 1}: 2, {1, 2}: 5, {2, 3}: 2}
+
+	testCases := []struct {
+		maxCardinality bool
+		expected       []Edge
+	}{
+		{maxCardinality: false, expected: []Edge{{1, 2}}},
+		{maxCardinality: true, expected: []Edge{{0, 1}, {2, 3}}},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("MaxCardinality=%v", tc.maxCardinality), func(t *testing.T) {
+			matching := MaxWeightMatching(g, weights, tc.maxCardinality)
+			if fmt.Sprint(matching) != fmt.Sprint(tc.expected) {
+				t.Errorf("Expected %v, but got %v", tc.expected, matching)
+			}
+		})
+	}
+}
+
+func TestMaxWeightMatching_NestedBlossoms(t *testing.T) {
+	// Test case from van Rantwijk's test suite: create nested S-blossom, augment, expand recursively
+	g := &UndirectedGraph{}
+	weights := map[Edge]float64{
+		{1, 2}: 19, {1, 3}: 20, {1, 8}: 8, {2, 3}: 25, {2, 4}: 18,
+		{3, 5}: 18, {4, 5}: 13, {4, 7}: 7, {5, 6}: 7,
+	}
+	for edge := range weights {
+		g.AddEdge(edge)
+	}
+
+	matching := MaxWeightMatching(g, weights, false)
+	expected := []Edge{{1, 8}, {2, 3}, {4, 7}, {5, 6}}
+	if fmt.Sprint(matching) != fmt.Sprint(expected) {
+		t.Errorf("Expected %v, but got %v", expected, matching)
+	}
+}
+
+func TestIsMatching(t *testing.T) {
+	g := CycleGraph(4)
+	if !IsMatching(g, []Edge{{0, 1}, {2, 3}}) {
+		t.Errorf("Expected {0-1, 2-3} to be a matching")
+	}
+	if IsMatching(g, []Edge{{0, 1}, {1, 2}}) {
+		t.Errorf("Expected edges sharing node 1 not to be a matching")
+	}
+	if IsMatching(g, []Edge{{0, 2}}) {
+		t.Errorf("Expected a non-edge not to be a matching")
+	}
+	if IsMaximalMatching(PathGraph(4), []Edge{{0, 1}}) {
+		t.Errorf("Expected {0-1} not to be maximal in a path of 4 nodes")
+	}
+}
@@ -0,0 +1,388 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+)
+
+// copyInto adds the nodes and edges of src to dst, renaming every node with rename.
+// Adjacency lists are copied entry by entry, so parallel edges and self-loops are preserved.
+func copyInto(dst *UndirectedGraph, src *UndirectedGraph, rename func(Node) Node) {
+	if dst.Edges == nil {
+		dst.Edges = make(map[Node][]Node)
+	}
+	for node := range src.Nodes {
+		dst.AddNode(rename(node))
+	}
+	for node, neighbours := range src.Edges {
+		for _, neighbor := range neighbours {
+			dst.Edges[rename(node)] = append(dst.Edges[rename(node)], rename(neighbor))
+		}
+	}
+}
+
+// edgeSet returns the edges of g as pairs with Node1 <= Node2, parallel edges counting once.
+func edgeSet(g *UndirectedGraph) map[Edge]bool {
+	edges := make(map[Edge]bool)
+	for node, neighbours := range g.Edges {
+		for _, neighbor := range neighbours {
+			if node <= neighbor {
+				edges[Edge{Node1: node, Node2: neighbor}] = true
+			}
+		}
+	}
+	return edges
+}
+
+// fromEdgeSet builds the graph with the given nodes and edges, adding the edges in sorted order.
+func fromEdgeSet(nodes map[Node]bool, edges map[Edge]bool) *UndirectedGraph {
+	g := &UndirectedGraph{
+		Nodes: make(map[Node]bool, len(nodes)),
+		Edges: make(map[Node][]Node, len(nodes)),
+	}
+	for _, node := range sortedNodes(nodes) {
+		g.AddNode(node)
+	}
+	sorted := make([]Edge, 0, len(edges))
+	for edge := range edges {
+		sorted = append(sorted, edge)
+	}
+	sortEdges(sorted)
+	for _, edge := range sorted {
+		g.AddEdge(edge)
+	}
+	return g
+}
+
+// sortEdges sorts edges by their first, then their second node.
+func sortEdges(edges []Edge) {
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Node1 != edges[j].Node1 {
+			return edges[i].Node1 < edges[j].Node1
+		}
+		return edges[i].Node2 < edges[j].Node2
+	})
+}
+
+// sameNodes reports whether both graphs have the same node set.
+func sameNodes(g1 *UndirectedGraph, g2 *UndirectedGraph) bool {
+	if len(g1.Nodes) != len(g2.Nodes) {
+		return false
+	}
+	for node := range g1.Nodes {
+		if !g2.Nodes[node] {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+Union returns the union of two graphs with disjoint node sets.
+
+Parameters:
+- g1, g2: The undirected graphs.
+
+Returns:
+- graph: A new graph with the nodes and edges of both graphs; parallel edges and self-loops are kept.
+- error: If the graphs share a node, in which case Compose or DisjointUnion should be used.
+*/
+func Union(g1 *UndirectedGraph, g2 *UndirectedGraph) (*UndirectedGraph, error) {
+	for node := range g2.Nodes {
+		if g1.Nodes[node] {
+			return nil, fmt.Errorf("node %d is in both graphs, use DisjointUnion or Compose", node)
+		}
+	}
+	g := &UndirectedGraph{}
+	identity := func(node Node) Node { return node }
+	copyInto(g, g1, identity)
+	copyInto(g, g2, identity)
+	return g, nil
+}
+
+/*
+DisjointUnion returns the union of two graphs after relabelling the nodes of the second one.
+
+Parameters:
+- g1, g2: The undirected graphs.
+
+Returns:
+- graph: A new graph with the nodes of g1 unchanged and the nodes of g2 renamed, in increasing order, to consecutive labels after the largest node of g1.
+- mapping: The new label of every node of g2.
+
+Example:
+
+	g, _ := DisjointUnion(CycleGraph(3), PathGraph(2))
+	fmt.Println(len(g.Nodes)) // Output: 5 (the path becomes 3 - 4)
+*/
+func DisjointUnion(g1 *UndirectedGraph, g2 *UndirectedGraph) (*UndirectedGraph, map[Node]Node) {
+	next := Node(0)
+	for node := range g1.Nodes {
+		next = max(next, node+1)
+	}
+	mapping := make(map[Node]Node, len(g2.Nodes))
+	for _, node := range sortedNodes(g2.Nodes) {
+		mapping[node] = next
+		next++
+	}
+
+	g := &UndirectedGraph{}
+	copyInto(g, g1, func(node Node) Node { return node })
+	copyInto(g, g2, func(node Node) Node { return mapping[node] })
+	return g, mapping
+}
+
+// Compose returns the graph with the nodes and edges of both graphs; an edge of both graphs is added once.
+// Parallel edges are merged, self-loops are kept.
+func Compose(g1 *UndirectedGraph, g2 *UndirectedGraph) *UndirectedGraph {
+	nodes := make(map[Node]bool, len(g1.Nodes)+len(g2.Nodes))
+	edges := edgeSet(g1)
+	for node := range g1.Nodes {
+		nodes[node] = true
+	}
+	for node := range g2.Nodes {
+		nodes[node] = true
+	}
+	for edge := range edgeSet(g2) {
+		edges[edge] = true
+	}
+	return fromEdgeSet(nodes, edges)
+}
+
+// Intersection returns the graph with the nodes and the edges that belong to both graphs.
+// Parallel edges are merged, self-loops are kept.
+func Intersection(g1 *UndirectedGraph, g2 *UndirectedGraph) *UndirectedGraph {
+	nodes := make(map[Node]bool)
+	for node := range g1.Nodes {
+		if g2.Nodes[node] {
+			nodes[node] = true
+		}
+	}
+	edges2 := edgeSet(g2)
+	edges := make(map[Edge]bool)
+	for edge := range edgeSet(g1) {
+		if edges2[edge] {
+			edges[edge] = true
+		}
+	}
+	return fromEdgeSet(nodes, edges)
+}
+
+/*
+Difference returns the graph with the edges of g1 that are not in g2.
+
+Parameters:
+- g1, g2: Undirected graphs with the same node set.
+
+Returns:
+- graph: A new graph on the common node set; parallel edges are merged, self-loops are kept.
+- error: If the node sets differ.
+*/
+func Difference(g1 *UndirectedGraph, g2 *UndirectedGraph) (*UndirectedGraph, error) {
+	if !sameNodes(g1, g2) {
+		return nil, fmt.Errorf("graphs must have the same nodes")
+	}
+	edges2 := edgeSet(g2)
+	edges := make(map[Edge]bool)
+	for edge := range edgeSet(g1) {
+		if !edges2[edge] {
+			edges[edge] = true
+		}
+	}
+	return fromEdgeSet(g1.Nodes, edges), nil
+}
+
+/*
+SymmetricDifference returns the graph with the edges that belong to exactly one of the graphs.
+
+Parameters:
+- g1, g2: Undirected graphs with the same node set.
+
+Returns:
+- graph: A new graph on the common node set; parallel edges are merged, self-loops are kept.
+- error: If the node sets differ.
+*/
+func SymmetricDifference(g1 *UndirectedGraph, g2 *UndirectedGraph) (*UndirectedGraph, error) {
+	if !sameNodes(g1, g2) {
+		return nil, fmt.Errorf("graphs must have the same nodes")
+	}
+	edges1, edges2 := edgeSet(g1), edgeSet(g2)
+	edges := make(map[Edge]bool)
+	for edge := range edges1 {
+		if !edges2[edge] {
+			edges[edge] = true
+		}
+	}
+	for edge := range edges2 {
+		if !edges1[edge] {
+			edges[edge] = true
+		}
+	}
+	return fromEdgeSet(g1.Nodes, edges), nil
+}
+
+// Complement returns the graph on the same nodes in which two distinct nodes are adjacent if and only if they are not adjacent in g.
+// The complement has no self-loops or parallel edges.
+func Complement(g *UndirectedGraph) *UndirectedGraph {
+	neighbours := neighbourSets(g)
+	nodes := sortedNodes(g.Nodes)
+	edges := make(map[Edge]bool)
+	for i, u := range nodes {
+		for _, v := range nodes[i+1:] {
+			if !neighbours[u][v] {
+				edges[Edge{Node1: u, Node2: v}] = true
+			}
+		}
+	}
+	return fromEdgeSet(g.Nodes, edges)
+}
+
+/*
+LineGraph returns the line graph of g, whose nodes are the edges of g.
+
+Parameters:
+- g: The undirected graph; self-loops and parallel edges are ignored.
+
+Returns:
+- graph: A graph in which two nodes are adjacent when the corresponding edges of g share an endpoint.
+- edges: The edge of g, with Node1 < Node2, represented by every node; nodes are numbered from 0 in increasing edge order.
+
+Example:
+
+	// The line graph of a star is a complete graph
+	line, _ := LineGraph(StarGraph(5))
+	fmt.Println(line.NumberOfEdges()) // Output: 6
+*/
+func LineGraph(g *UndirectedGraph) (*UndirectedGraph, map[Node]Edge) {
+	nodes, _, adjacency := simpleAdjacency(g)
+	var edges []Edge
+	// incident lists the line graph nodes of the edges incident to every node of g
+	incident := make([][]Node, len(nodes))
+	for u, neighbours := range adjacency {
+		for _, v := range neighbours {
+			if u < v {
+				incident[u] = append(incident[u], Node(len(edges)))
+				incident[v] = append(incident[v], Node(len(edges)))
+				edges = append(edges, Edge{Node1: nodes[u], Node2: nodes[v]})
+			}
+		}
+	}
+
+	line := &UndirectedGraph{}
+	mapping := make(map[Node]Edge, len(edges))
+	for i, edge := range edges {
+		line.AddNode(Node(i))
+		mapping[Node(i)] = edge
+	}
+	lineEdges := make(map[Edge]bool)
+	for _, around := range incident {
+		for i, a := range around {
+			for _, b := range around[i+1:] {
+				lineEdges[Edge{Node1: min(a, b), Node2: max(a, b)}] = true
+			}
+		}
+	}
+	sorted := make([]Edge, 0, len(lineEdges))
+	for edge := range lineEdges {
+		sorted = append(sorted, edge)
+	}
+	sortEdges(sorted)
+	for _, edge := range sorted {
+		line.AddEdge(edge)
+	}
+	return line, mapping
+}
+
+// graphProduct returns the product of two graphs in which (u, v) and (u', v') are adjacent when
+// adjacent(u == u', u ~ u', v == v', v ~ v') holds. The node (u, v) is numbered i*|V2|+j, where i and j are
+// the ranks of u in g1 and v in g2, and is mapped back to [u, v].
+func graphProduct(g1 *UndirectedGraph, g2 *UndirectedGraph, adjacent func(sameFirst, adjacentFirst, sameSecond, adjacentSecond bool) bool) (*UndirectedGraph, map[Node][2]Node) {
+	nodes1, _, adjacency1 := simpleAdjacency(g1)
+	nodes2, _, adjacency2 := simpleAdjacency(g2)
+	n2 := len(nodes2)
+	adjacent2 := make([]map[int]bool, n2)
+	for j, neighbours := range adjacency2 {
+		adjacent2[j] = make(map[int]bool, len(neighbours))
+		for _, other := range neighbours {
+			adjacent2[j][other] = true
+		}
+	}
+
+	g := &UndirectedGraph{}
+	pairs := make(map[Node][2]Node, len(nodes1)*n2)
+	for i := range nodes1 {
+		for j := range nodes2 {
+			g.AddNode(Node(i*n2 + j))
+			pairs[Node(i*n2+j)] = [2]Node{nodes1[i], nodes2[j]}
+		}
+	}
+	for i := range nodes1 {
+		// Pairs inside the same copy of g2, then pairs between copies of adjacent nodes of g1
+		firsts := append([]int{i}, adjacency1[i]...)
+		for _, other := range firsts {
+			if other < i {
+				continue
+			}
+			for j := 0; j < n2; j++ {
+				for k := 0; k < n2; k++ {
+					if other == i && k <= j {
+						continue
+					}
+					if adjacent(other == i, other != i, j == k, adjacent2[j][k]) {
+						g.AddEdge(Edge{
+							Node1: Node(i*n2 + j),
+							Node2: Node(other*n2 + k),
+						})
+					}
+				}
+			}
+		}
+	}
+	return g, pairs
+}
+
+/*
+CartesianProduct returns the Cartesian product of two graphs.
+
+Parameters:
+- g1, g2: The undirected graphs; self-loops and parallel edges are ignored.
+
+Returns:
+- graph: The graph on pairs (u, v) in which (u, v) and (u', v') are adjacent when u = u' and v ~ v', or v = v' and u ~ u'.
+- pairs: The pair [u, v] of every node; (u, v) is numbered i*|V2|+j, where i and j are the ranks of u in g1 and v in g2.
+
+Description:
+With generators numbered from 0 the numbering matches GridNode, so the product
+of two paths is Grid2DGraph, the product of two cycles is TorusGraph and
+LadderGraph is isomorphic to the product of a path with K2.
+*/
+func CartesianProduct(g1 *UndirectedGraph, g2 *UndirectedGraph) (*UndirectedGraph, map[Node][2]Node) {
+	return graphProduct(g1, g2, func(sameFirst, adjacentFirst, sameSecond, adjacentSecond bool) bool {
+		return (sameFirst && adjacentSecond) || (adjacentFirst && sameSecond)
+	})
+}
+
+// TensorProduct returns the tensor (categorical) product of two graphs, in which (u, v) and (u', v') are adjacent
+// when u ~ u' and v ~ v'. Nodes are numbered as in CartesianProduct.
+func TensorProduct(g1 *UndirectedGraph, g2 *UndirectedGraph) (*UndirectedGraph, map[Node][2]Node) {
+	return graphProduct(g1, g2, func(sameFirst, adjacentFirst, sameSecond, adjacentSecond bool) bool {
+		return adjacentFirst && adjacentSecond
+	})
+}
+
+// StrongProduct returns the strong product of two graphs, the union of the Cartesian and tensor products:
+// distinct (u, v) and (u', v') are adjacent when u and u' are equal or adjacent, and so are v and v'.
+// Nodes are numbered as in CartesianProduct.
+func StrongProduct(g1 *UndirectedGraph, g2 *UndirectedGraph) (*UndirectedGraph, map[Node][2]Node) {
+	return graphProduct(g1, g2, func(sameFirst, adjacentFirst, sameSecond, adjacentSecond bool) bool {
+		return (sameFirst || adjacentFirst) && (sameSecond || adjacentSecond) && !(sameFirst && sameSecond)
+	})
+}
+
+// LexicographicProduct returns the lexicographic product g1[g2], in which (u, v) and (u', v') are adjacent when
+// u ~ u', or u = u' and v ~ v': every node of g1 is replaced by a copy of g2. Nodes are numbered as in CartesianProduct.
+func LexicographicProduct(g1 *UndirectedGraph, g2 *UndirectedGraph) (*UndirectedGraph, map[Node][2]Node) {
+	return graphProduct(g1, g2, func(sameFirst, adjacentFirst, sameSecond, adjacentSecond bool) bool {
+		return adjacentFirst || (sameFirst && adjacentSecond)
+	})
+}
@@ -0,0 +1,200 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestUnion(t *testing.T) {
+	shifted, _ := RelabelNodes(CycleGraph(3), map[Node]Node{0: 3, 1: 4, 2: 5}, false)
+	g, err := Union(CycleGraph(3), shifted)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !g.Equals(twoTriangles()) {
+		t.Errorf("Expected two triangles, but got %v", g)
+	}
+	if _, err := Union(CycleGraph(3), PathGraph(2)); err == nil {
+		t.Errorf("Expected an error for overlapping node sets")
+	}
+}
+
+func TestDisjointUnion(t *testing.T) {
+	g, mapping := DisjointUnion(CycleGraph(3), CycleGraph(3))
+	if !g.Equals(twoTriangles()) {
+		t.Errorf("Expected two triangles, but got %v", g)
+	}
+	if mapping[0] != 3 || mapping[1] != 4 || mapping[2] != 5 {
+		t.Errorf("Unexpected mapping %v", mapping)
+	}
+
+	// Self-loops and parallel edges are preserved
+	multigraph := &UndirectedGraph{}
+	multigraph.AddEdgesFromIntTupleList([][2]int{{0, 0}, {0, 1}, {0, 1}})
+	g, _ = DisjointUnion(PathGraph(2), multigraph)
+	if g.NodeDegree(2) != 4 || g.NodeDegree(3) != 2 || g.NumberOfEdges() != 4 {
+		t.Errorf("Multigraph was not copied: %v", g)
+	}
+	g, mapping = DisjointUnion(NullGraph(), PathGraph(2))
+	if !g.Equals(PathGraph(2)) || mapping[1] != 1 {
+		t.Errorf("Expected the path unchanged, but got %v with %v", g, mapping)
+	}
+}
+
+func TestSetOperators(t *testing.T) {
+	// A 4-cycle and the path 0 - 1 - 2 - 3 plus the chord 0 - 2
+	cycle := CycleGraph(4)
+	other := PathGraph(4)
+	other.AddEdge(Edge{Node1: 0, Node2: 2})
+
+	intersection := Intersection(cycle, other)
+	validateGraph(t, intersection,
+		map[Node]bool{0: true, 1: true, 2: true, 3: true},
+		map[Node][]Node{0: {1}, 1: {0, 2}, 2: {1, 3}, 3: {2}})
+
+	difference, err := Difference(cycle, other)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	validateGraph(t, difference,
+		map[Node]bool{0: true, 1: true, 2: true, 3: true},
+		map[Node][]Node{0: {3}, 3: {0}})
+
+	symmetric, _ := SymmetricDifference(cycle, other)
+	validateGraph(t, symmetric,
+		map[Node]bool{0: true, 1: true, 2: true, 3: true},
+		map[Node][]Node{0: {2, 3}, 2: {0}, 3: {0}})
+
+	composed := Compose(cycle, other)
+	if composed.NumberOfEdges() != 5 || !composed.HasEdge(Edge{Node1: 0, Node2: 2}) {
+		t.Errorf("Expected the cycle with the chord, but got %v", composed)
+	}
+	// Compose accepts different node sets
+	shiftedPath, _ := RelabelNodes(PathGraph(3), map[Node]Node{0: 2, 1: 3, 2: 4}, false)
+	composed = Compose(PathGraph(3), shiftedPath)
+	if !composed.Equals(PathGraph(5)) {
+		t.Errorf("Expected a path on 5 nodes, but got %v", composed)
+	}
+	if g := Intersection(PathGraph(3), PathGraph(5)); !g.Equals(PathGraph(3)) {
+		t.Errorf("Expected a path on 3 nodes, but got %v", g)
+	}
+
+	if _, err := Difference(PathGraph(3), PathGraph(4)); err == nil {
+		t.Errorf("Expected an error for different node sets")
+	}
+	if _, err := SymmetricDifference(PathGraph(3), PathGraph(4)); err == nil {
+		t.Errorf("Expected an error for different node sets")
+	}
+}
+
+func TestComplement(t *testing.T) {
+	if !IsIsomorphic(Complement(CycleGraph(5)), CycleGraph(5), nil, nil) {
+		t.Errorf("Expected the 5-cycle to be self-complementary")
+	}
+	if !Complement(CompleteBipartiteGraph(3, 3)).Equals(twoTriangles()) {
+		t.Errorf("Expected two triangles as the complement of K_{3,3}")
+	}
+	if g := Complement(CompleteGraph(4)); len(g.Nodes) != 4 || g.NumberOfEdges() != 0 {
+		t.Errorf("Expected 4 isolated nodes, but got %v", g)
+	}
+	// Union with the complement gives the complete graph
+	if !Compose(PetersenGraph(), Complement(PetersenGraph())).Equals(CompleteGraph(10)) {
+		t.Errorf("Expected K10")
+	}
+}
+
+func TestLineGraph(t *testing.T) {
+	line, edges := LineGraph(StarGraph(5))
+	if !line.Equals(CompleteGraph(4)) {
+		t.Errorf("Expected K4, but got %v", line)
+	}
+	if edges[0] != (Edge{Node1: 0, Node2: 1}) || edges[3] != (Edge{Node1: 0, Node2: 4}) {
+		t.Errorf("Unexpected edge mapping %v", edges)
+	}
+
+	tests := []struct {
+		name     string
+		graph    *UndirectedGraph
+		expected *UndirectedGraph
+	}{
+		{"Cycle", CycleGraph(6), CycleGraph(6)},
+		{"Path", PathGraph(5), PathGraph(4)},
+		{"K4", CompleteGraph(4), OctahedralGraph()},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			line, _ := LineGraph(test.graph)
+			if !IsIsomorphic(line, test.expected, nil, nil) {
+				t.Errorf("Expected %v, but got %v", test.expected, line)
+			}
+		})
+	}
+
+	// Adjacent nodes of the line graph represent edges sharing an endpoint
+	line, edges = LineGraph(PetersenGraph())
+	if len(line.Nodes) != 15 || line.NumberOfEdges() != 30 {
+		t.Errorf("Expected 15 nodes and 30 edges, but got %d and %d", len(line.Nodes), line.NumberOfEdges())
+	}
+	for _, edge := range line.GetEdgeTuples() {
+		a, b := edges[edge.Node1], edges[edge.Node2]
+		if a.Node1 != b.Node1 && a.Node1 != b.Node2 && a.Node2 != b.Node1 && a.Node2 != b.Node2 {
+			t.Errorf("Edges %v and %v share no endpoint", a, b)
+		}
+	}
+}
+
+func TestGraphProducts(t *testing.T) {
+	grid, pairs := CartesianProduct(PathGraph(3), PathGraph(4))
+	expected, _ := Grid2DGraph(3, 4)
+	if !grid.Equals(expected) {
+		t.Errorf("Expected the 3x4 grid, but got %v", grid)
+	}
+	if pairs[6] != [2]Node{1, 2} {
+		t.Errorf("Expected node 6 to be (1, 2), but got %v", pairs[6])
+	}
+
+	torus, _ := CartesianProduct(CycleGraph(3), CycleGraph(5))
+	expected, _ = TorusGraph(3, 5)
+	if !torus.Equals(expected) {
+		t.Errorf("Expected the 3x5 torus, but got %v", torus)
+	}
+	ladder, _ := CartesianProduct(PathGraph(5), PathGraph(2))
+	if !IsIsomorphic(ladder, LadderGraph(5), nil, nil) {
+		t.Errorf("Expected a ladder, but got %v", ladder)
+	}
+	square, _ := CartesianProduct(PathGraph(2), PathGraph(2))
+	cube, _ := CartesianProduct(square, PathGraph(2))
+	if !cube.Equals(CubicalGraph()) {
+		t.Errorf("Expected the cube, but got %v", cube)
+	}
+
+	tensor, _ := TensorProduct(PathGraph(2), CompleteGraph(3))
+	if !IsIsomorphic(tensor, CycleGraph(6), nil, nil) {
+		t.Errorf("Expected K2 x K3 to be a 6-cycle, but got %v", tensor)
+	}
+	// The strong product of two paths is the king's graph
+	king, _ := StrongProduct(PathGraph(3), PathGraph(3))
+	if king.NumberOfEdges() != 20 || king.NodeDegree(4) != 8 {
+		t.Errorf("Expected the 3x3 king's graph, but got %v", king)
+	}
+	strong, _ := StrongProduct(CompleteGraph(2), CompleteGraph(3))
+	if !IsIsomorphic(strong, CompleteGraph(6), nil, nil) {
+		t.Errorf("Expected K6, but got %v", strong)
+	}
+
+	empty := NullGraph()
+	empty.AddNodes([]Node{0, 1})
+	lexicographic, _ := LexicographicProduct(CycleGraph(5), empty)
+	if lexicographic.NumberOfEdges() != 20 || lexicographic.HasEdge(Edge{Node1: 0, Node2: 1}) {
+		t.Errorf("Expected every node of C5 to be replaced by 2 independent nodes, but got %v", lexicographic)
+	}
+	lexicographic, _ = LexicographicProduct(CompleteGraph(3), CompleteGraph(2))
+	if !IsIsomorphic(lexicographic, CompleteGraph(6), nil, nil) {
+		t.Errorf("Expected K6, but got %v", lexicographic)
+	}
+	// The lexicographic product is not commutative
+	g1, _ := LexicographicProduct(PathGraph(3), PathGraph(2))
+	g2, _ := LexicographicProduct(PathGraph(2), PathGraph(3))
+	if g1.NumberOfEdges() != 11 || g2.NumberOfEdges() != 13 {
+		t.Errorf("Expected 11 and 13 edges, but got %d and %d", g1.NumberOfEdges(), g2.NumberOfEdges())
+	}
+}
@@ -0,0 +1,51 @@
+package model
+
+import "math/rand"
+
+// RandomOption configures the source of randomness of a random graph generator or randomised algorithm.
+//
+// Example:
+//
+//	// The same seed always produces the same graph
+//	g1 := FastGNPRandomGraph(100, 0.1, WithSeed(42))
+//	g2 := FastGNPRandomGraph(100, 0.1, WithSeed(42))
+//	fmt.Println(g1.Equals(&g2)) // Output: true
+type RandomOption func(*randomConfig)
+
+type randomConfig struct {
+	random *rand.Rand
+}
+
+// WithSeed makes the function draw its random numbers from a new source seeded with seed.
+func WithSeed(seed int64) RandomOption {
+	return func(config *randomConfig) {
+		config.random = rand.New(rand.NewSource(seed))
+	}
+}
+
+// WithRand makes the function draw its random numbers from random, which lets several calls share a single
+// seeded source. A *rand.Rand is not safe for concurrent use, so it must not be shared between goroutines.
+func WithRand(random *rand.Rand) RandomOption {
+	return func(config *randomConfig) {
+		config.random = random
+	}
+}
+
+// newRandom returns the source selected by the options; without options, or with a nil *rand.Rand, it returns
+// a new source seeded from the global one, so that unseeded calls still give different results.
+func newRandom(options ...RandomOption) *rand.Rand {
+	config := randomConfig{}
+	for _, option := range options {
+		option(&config)
+	}
+	return randomOrDefault(config.random)
+}
+
+// randomOrDefault returns random, or a new randomly seeded source if random is nil. Strategies whose
+// Rand field is left unset use it to draw from a fresh source.
+func randomOrDefault(random *rand.Rand) *rand.Rand {
+	if random != nil {
+		return random
+	}
+	return rand.New(rand.NewSource(rand.Int63()))
+}
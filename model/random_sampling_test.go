@@ -2,7 +2,6 @@ package model
 
 import (
 	"fmt"
-	"math/rand"
 	"testing"
 )
 
@@ -15,11 +14,8 @@ func BenchmarkFastGNPRandomGraph(b *testing.B) {
 
 	// Run the benchmark
 	for i := 0; i < b.N; i++ {
-		// Reset the random seed for each iteration to ensure consistent results
-		rand.NewSource(int64(i))
-
-		// Run the FastGNPRandomGraph function
-		g := FastGNPRandomGraph(numberOfNodes, probabilityForEdgeCreation)
+		// Seed each iteration to ensure consistent results
+		g := FastGNPRandomGraph(numberOfNodes, probabilityForEdgeCreation, WithSeed(int64(i)))
 		fmt.Println(g)
 	}
 }
@@ -0,0 +1,186 @@
+package model
+
+import (
+	"maps"
+	"math/rand"
+	"testing"
+
+	"github.com/jinzhu/copier"
+)
+
+func TestRandomGeneratorsAreReproducible(t *testing.T) {
+	generators := map[string]func(options ...RandomOption) *UndirectedGraph{
+		"FastGNP": func(options ...RandomOption) *UndirectedGraph {
+			g := FastGNPRandomGraph(60, 0.1, options...)
+			return &g
+		},
+		"DenseGNM": func(options ...RandomOption) *UndirectedGraph {
+			return DenseGNMRandomGraph(30, 40, options...)
+		},
+		"WattsStrogatz": func(options ...RandomOption) *UndirectedGraph {
+			return WattsStrogatzRandomGraph(60, 4, 0.3, options...)
+		},
+		"RandomBipartite": func(options ...RandomOption) *UndirectedGraph {
+			g, _ := RandomBipartiteGraph(20, 30, 0.2, options...)
+			return g
+		},
+		"RandomLabelledTree": func(options ...RandomOption) *UndirectedGraph {
+			g, _ := RandomLabelledTree(60, options...)
+			return g
+		},
+		"RandomRecursiveTree": func(options ...RandomOption) *UndirectedGraph {
+			g, _ := RandomRecursiveTree(60, options...)
+			return g
+		},
+	}
+	for name, generate := range generators {
+		t.Run(name, func(t *testing.T) {
+			g1, g2 := generate(WithSeed(42)), generate(WithSeed(42))
+			if !g1.Equals(g2) {
+				t.Errorf("Expected the same graph for the same seed, but got %v and %v", g1, g2)
+			}
+			if g3 := generate(WithSeed(43)); g1.Equals(g3) {
+				t.Errorf("Expected different graphs for different seeds, but got %v twice", g1)
+			}
+			// A shared source keeps advancing, so consecutive calls differ
+			random := rand.New(rand.NewSource(42))
+			if g4 := generate(WithRand(random)); !g1.Equals(g4) {
+				t.Errorf("Expected WithRand to match WithSeed, but got %v and %v", g1, g4)
+			}
+			if g5 := generate(WithRand(random)); g1.Equals(g5) {
+				t.Errorf("Expected the second call on a shared source to differ, but got %v twice", g1)
+			}
+		})
+	}
+}
+
+func TestRandomAlgorithmsAreReproducible(t *testing.T) {
+	g := FastGNPRandomGraph(40, 0.2, WithSeed(1))
+
+	set1, _ := MaximalIndependentSet(&g, nil, WithSeed(7))
+	set2, _ := MaximalIndependentSet(&g, nil, WithSeed(7))
+	if !maps.Equal(set1, set2) {
+		t.Errorf("Expected the same independent set for the same seed, but got %v and %v", set1, set2)
+	}
+
+	similarity1, _ := MonteCarloSimRank(&g, 0, 0.8, 20, 10, WithSeed(7))
+	similarity2, _ := MonteCarloSimRank(&g, 0, 0.8, 20, 10, WithSeed(7))
+	for node, score := range similarity1 {
+		if similarity2[node] != score {
+			t.Errorf("Expected the same SimRank estimate for node %d, but got %v and %v", node, score, similarity2[node])
+		}
+	}
+
+	scores1, _ := GraphletSignificance(&g, 3, 2, WithSeed(7))
+	scores2, _ := GraphletSignificance(&g, 3, 2, WithSeed(7))
+	for graphlet, score := range scores1 {
+		if scores2[graphlet] != score {
+			t.Errorf("Expected the same score for %v, but got %v and %v", graphlet, score, scores2[graphlet])
+		}
+	}
+
+	coloring1 := GreedyColor(&g, &RandomSequentialColoring{Rand: rand.New(rand.NewSource(7))})
+	coloring2 := GreedyColor(&g, &RandomSequentialColoring{Rand: rand.New(rand.NewSource(7))})
+	for node, color := range coloring1 {
+		if coloring2[node] != color {
+			t.Errorf("Expected the same colour for node %d, but got %d and %d", node, color, coloring2[node])
+		}
+	}
+}
+
+func TestDeletionSamplingIsReproducible(t *testing.T) {
+	g := FastGNPRandomGraph(80, 0.1, WithSeed(3))
+	strategies := map[string]func(random *rand.Rand) IDeletionSamplingStrategy{
+		"RandomNode": func(random *rand.Rand) IDeletionSamplingStrategy { return &DeletionRandomNodeSampling{Rand: random} },
+		"RandomDegreeNode": func(random *rand.Rand) IDeletionSamplingStrategy {
+			return &DeletionRandomDegreeNodeSampling{Rand: random}
+		},
+		"RandomEdge": func(random *rand.Rand) IDeletionSamplingStrategy { return &DeletionRandomEdgeSampling{Rand: random} },
+		"Hybrid":     func(random *rand.Rand) IDeletionSamplingStrategy { return &DeletionHybridSampling{Rand: random} },
+		"RandomWalk": func(random *rand.Rand) IDeletionSamplingStrategy { return &DeletionRandomWalkSampling{Rand: random} },
+		"RandomWalkWithJump": func(random *rand.Rand) IDeletionSamplingStrategy {
+			return &DeletionRandomWalkWithJumpSampling{Rand: random}
+		},
+	}
+	for name, strategy := range strategies {
+		t.Run(name, func(t *testing.T) {
+			var samples [2]*UndirectedGraph
+			for i := range samples {
+				samples[i] = &UndirectedGraph{}
+				if err := copier.CopyWithOption(samples[i], &g, copier.Option{DeepCopy: true}); err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if err := strategy(rand.New(rand.NewSource(5))).SamplingStage(samples[i], 10); err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			}
+			if !samples[0].Equals(samples[1]) {
+				t.Errorf("Expected the same sample for the same seed, but got %v and %v", samples[0], samples[1])
+			}
+			if samples[0].Equals(&g) {
+				t.Errorf("Expected the sampling stage to change the graph")
+			}
+		})
+	}
+}
+
+func TestPreservationSamplingIsReproducible(t *testing.T) {
+	// The random walks only stop once they have visited enough nodes, so the graph must be connected
+	g, _ := KarateClubGraph()
+	byValue := func(sample func(UndirectedGraph, float32) (UndirectedGraph, error)) func() (*UndirectedGraph, error) {
+		return func() (*UndirectedGraph, error) {
+			sampled, err := sample(*g, 0.3)
+			return &sampled, err
+		}
+	}
+	strategies := map[string]func(random *rand.Rand) func() (*UndirectedGraph, error){
+		"RandomNode": func(random *rand.Rand) func() (*UndirectedGraph, error) {
+			return byValue((&PreservationRandomNodeSampling{Rand: random}).Sample)
+		},
+		"RandomNodeNeighbour": func(random *rand.Rand) func() (*UndirectedGraph, error) {
+			return byValue((&PreservationRandomNodeNeighbourSampling{Rand: random}).Sample)
+		},
+		"InclusiveRandomNodeNeighbour": func(random *rand.Rand) func() (*UndirectedGraph, error) {
+			return byValue((&PreservationInclusiveRandomNodeNeighbourSampling{Rand: random}).Sample)
+		},
+		"RandomDegreeNode": func(random *rand.Rand) func() (*UndirectedGraph, error) {
+			return byValue((&PreservationRandomDegreeNodeSampling{Rand: random}).Sample)
+		},
+		"RandomEdge": func(random *rand.Rand) func() (*UndirectedGraph, error) {
+			return byValue((&PreservationRandomEdgeSampling{Rand: random}).Sample)
+		},
+		"RandomNodeEdge": func(random *rand.Rand) func() (*UndirectedGraph, error) {
+			return byValue((&PreservationRandomNodeEdgeSampling{Rand: random}).Sample)
+		},
+		"Hybrid": func(random *rand.Rand) func() (*UndirectedGraph, error) {
+			return func() (*UndirectedGraph, error) { return (&PreservationHybridSampling{Rand: random}).Sample(g, 0.3) }
+		},
+		"RandomWalk": func(random *rand.Rand) func() (*UndirectedGraph, error) {
+			return byValue((&PreservationRandomWalkSampling{Rand: random}).Sample)
+		},
+		"RandomWalkWithRestart": func(random *rand.Rand) func() (*UndirectedGraph, error) {
+			return byValue((&PreservationRandomWalkWithRestartSampling{Rand: random}).Sample)
+		},
+		"RandomWalkWithJump": func(random *rand.Rand) func() (*UndirectedGraph, error) {
+			return byValue((&PreservationRandomWalkWithJumpSampling{Rand: random}).Sample)
+		},
+	}
+	for name, strategy := range strategies {
+		t.Run(name, func(t *testing.T) {
+			var samples [2]*UndirectedGraph
+			for i := range samples {
+				sample, err := strategy(rand.New(rand.NewSource(5)))()
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				samples[i] = sample
+			}
+			if !samples[0].Equals(samples[1]) {
+				t.Errorf("Expected the same sample for the same seed, but got %v and %v", samples[0], samples[1])
+			}
+			if len(samples[0].Nodes) == 0 {
+				t.Errorf("Expected a non-empty sample")
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+)
+
+// NodeOrdering selects the order in which ConvertNodeLabelsToIntegers numbers the nodes.
+type NodeOrdering int
+
+const (
+	// SortedOrdering numbers the nodes by increasing label.
+	SortedOrdering NodeOrdering = iota
+	// IncreasingDegreeOrdering numbers the nodes by increasing degree, ties broken by label.
+	IncreasingDegreeOrdering
+	// DecreasingDegreeOrdering numbers the nodes by decreasing degree, ties broken by label.
+	DecreasingDegreeOrdering
+)
+
+/*
+RelabelNodes renames the nodes of a graph.
+
+Parameters:
+- g: The undirected graph.
+- mapping: The new label of every node to rename; nodes missing from it keep their label.
+- inPlace: If true, g itself is relabelled and returned, otherwise g is left unchanged and a relabelled copy is returned.
+
+Returns:
+- graph: The relabelled graph; parallel edges and self-loops are preserved.
+- error: If two nodes would get the same label, in which case g is left unchanged.
+
+Description:
+The mapping may swap labels or rename nodes to labels that are already used,
+as long as the result is a one-to-one renaming, e.g. {0: 1, 1: 0}.
+
+Example:
+
+	g, _ := RelabelNodes(PathGraph(3), map[Node]Node{0: 10, 2: 12}, false)
+	fmt.Println(g.HasEdge(Edge{Node1: 10, Node2: 1})) // Output: true
+*/
+func RelabelNodes(g *UndirectedGraph, mapping map[Node]Node, inPlace bool) (*UndirectedGraph, error) {
+	rename := func(node Node) Node {
+		if label, ok := mapping[node]; ok {
+			return label
+		}
+		return node
+	}
+	previous := make(map[Node]Node, len(g.Nodes))
+	for _, node := range sortedNodes(g.Nodes) {
+		label := rename(node)
+		if other, ok := previous[label]; ok {
+			return nil, fmt.Errorf("nodes %d and %d would both be relabelled %d", other, node, label)
+		}
+		previous[label] = node
+	}
+
+	relabelled := &UndirectedGraph{
+		Nodes: make(map[Node]bool, len(g.Nodes)),
+		Edges: make(map[Node][]Node, len(g.Edges)),
+	}
+	copyInto(relabelled, g, rename)
+	if !inPlace {
+		return relabelled, nil
+	}
+	g.Nodes, g.Edges = relabelled.Nodes, relabelled.Edges
+	return g, nil
+}
+
+/*
+ConvertNodeLabelsToIntegers renumbers the nodes of a graph with consecutive integers.
+
+Parameters:
+- g: The undirected graph.
+- first: The label of the first node.
+- ordering: The order in which the nodes are numbered.
+- inPlace: If true, g itself is relabelled and returned, otherwise a relabelled copy is returned.
+
+Returns:
+- graph: The graph on nodes first .. first+|V|-1.
+- original: The original label of every new node, so RelabelNodes(graph, original, ...) translates the graph back.
+
+Example:
+
+	// The centre of a star gets label 0 when the highest degree comes first
+	star, _ := RelabelNodes(StarGraph(6), map[Node]Node{0: 5, 5: 0}, false)
+	g, original := ConvertNodeLabelsToIntegers(star, 0, DecreasingDegreeOrdering, false)
+	fmt.Println(original[0]) // Output: 5
+*/
+func ConvertNodeLabelsToIntegers(g *UndirectedGraph, first int, ordering NodeOrdering, inPlace bool) (*UndirectedGraph, map[Node]Node) {
+	nodes := sortedNodes(g.Nodes)
+	switch ordering {
+	case IncreasingDegreeOrdering:
+		sort.SliceStable(nodes, func(i, j int) bool { return g.NodeDegree(nodes[i]) < g.NodeDegree(nodes[j]) })
+	case DecreasingDegreeOrdering:
+		sort.SliceStable(nodes, func(i, j int) bool { return g.NodeDegree(nodes[i]) > g.NodeDegree(nodes[j]) })
+	}
+
+	mapping := make(map[Node]Node, len(nodes))
+	original := make(map[Node]Node, len(nodes))
+	for i, node := range nodes {
+		mapping[node] = Node(first + i)
+		original[Node(first+i)] = node
+	}
+	// The mapping is one-to-one, so relabelling can't fail
+	relabelled, _ := RelabelNodes(g, mapping, inPlace)
+	return relabelled, original
+}
@@ -0,0 +1,97 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestRelabelNodes(t *testing.T) {
+	path := PathGraph(3)
+	g, err := RelabelNodes(path, map[Node]Node{0: 10, 2: 12}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	validateGraph(t, g,
+		map[Node]bool{10: true, 1: true, 12: true},
+		map[Node][]Node{10: {1}, 1: {10, 12}, 12: {1}})
+	if !path.Equals(PathGraph(3)) {
+		t.Errorf("Copy mode modified the original graph: %v", path)
+	}
+
+	// Swapping labels in place keeps the same graph value
+	star := StarGraph(4)
+	relabelled, err := RelabelNodes(star, map[Node]Node{0: 3, 3: 0}, true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if relabelled != star || star.NodeDegree(3) != 3 || star.NodeDegree(0) != 1 || !star.HasEdge(Edge{Node1: 0, Node2: 3}) {
+		t.Errorf("Expected the centre to become 3, but got %v", star)
+	}
+
+	// Self-loops and parallel edges survive
+	multigraph := &UndirectedGraph{}
+	multigraph.AddEdgesFromIntTupleList([][2]int{{0, 0}, {0, 1}, {0, 1}})
+	g, _ = RelabelNodes(multigraph, map[Node]Node{0: 5}, false)
+	if g.NodeDegree(5) != 4 || g.NumberOfEdges() != 3 {
+		t.Errorf("Multigraph was not relabelled correctly: %v", g)
+	}
+}
+
+func TestRelabelNodes_Error(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mapping map[Node]Node
+	}{
+		{"Merge", map[Node]Node{0: 5, 1: 5}},
+		{"CollisionWithUnmapped", map[Node]Node{0: 2}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := PathGraph(3)
+			if _, err := RelabelNodes(g, tc.mapping, true); err == nil {
+				t.Errorf("Expected an error")
+			}
+			if !g.Equals(PathGraph(3)) {
+				t.Errorf("Failed relabelling modified the graph: %v", g)
+			}
+		})
+	}
+}
+
+func TestConvertNodeLabelsToIntegers(t *testing.T) {
+	// A star with centre 50 and leaves 10, 20, 30, plus a pendant 40 on leaf 10
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{50, 10}, {50, 20}, {50, 30}, {10, 40}})
+
+	testCases := []struct {
+		name     string
+		ordering NodeOrdering
+		expected []Node
+	}{
+		{"Sorted", SortedOrdering, []Node{10, 20, 30, 40, 50}},
+		{"IncreasingDegree", IncreasingDegreeOrdering, []Node{20, 30, 40, 10, 50}},
+		{"DecreasingDegree", DecreasingDegreeOrdering, []Node{50, 10, 20, 30, 40}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			converted, original := ConvertNodeLabelsToIntegers(g, 1, tc.ordering, false)
+			for i, node := range tc.expected {
+				if original[Node(i+1)] != node {
+					t.Errorf("Expected node %d to be %d, but got %d", i+1, node, original[Node(i+1)])
+				}
+			}
+			if !converted.HasNode(1) || !converted.HasNode(5) || converted.HasNode(0) {
+				t.Errorf("Expected nodes 1 .. 5, but got %v", converted)
+			}
+			back, err := RelabelNodes(converted, original, false)
+			if err != nil || !back.Equals(g) {
+				t.Errorf("Translating back gave %v (%v)", back, err)
+			}
+		})
+	}
+
+	inPlace, _ := RelabelNodes(PathGraph(3), map[Node]Node{0: 7, 1: 8, 2: 9}, false)
+	converted, _ := ConvertNodeLabelsToIntegers(inPlace, 0, SortedOrdering, true)
+	if converted != inPlace || !inPlace.Equals(PathGraph(3)) {
+		t.Errorf("Expected the graph to be relabelled in place, but got %v", inPlace)
+	}
+}
@@ -28,43 +28,80 @@ type SamplingStrategy struct {
 
 /*
 DELETION GRAPH SAMPLING METHODS
+
+Every randomised strategy draws from its Rand field, so that for example
+&DeletionRandomNodeSampling{Rand: rand.New(rand.NewSource(42))} always returns
+the same sample; a nil Rand uses a randomly seeded source.
 */
-type DeletionRandomNodeSampling struct{ IDeletionSamplingStrategy }
-type DeletionRandomNodeNeighbourSampling struct{ IDeletionSamplingStrategy }
-type DeletionInclusiveRandomNodeNeighbourSampling struct{ IDeletionSamplingStrategy }
-type DeletionRandomDegreeNodeSampling struct{ IDeletionSamplingStrategy }
-type DeletionRandomEdgeSampling struct{ IDeletionSamplingStrategy }
-type DeletionRandomNodeEdgeSampling struct{ IDeletionSamplingStrategy }
-type DeletionHybridSampling struct{ IDeletionSamplingStrategy }
-type DeletionRandomWalkSampling struct{ IDeletionSamplingStrategy }
-type DeletionRandomWalkWithJumpSampling struct{ IDeletionSamplingStrategy }
-type DeletionRandomWalkWithRestartSampling struct{ IDeletionSamplingStrategy }
+type DeletionRandomNodeSampling struct {
+	IDeletionSamplingStrategy
+	Rand *rand.Rand
+}
+type DeletionRandomNodeNeighbourSampling struct {
+	IDeletionSamplingStrategy
+	Rand *rand.Rand
+}
+type DeletionInclusiveRandomNodeNeighbourSampling struct {
+	IDeletionSamplingStrategy
+	Rand *rand.Rand
+}
+type DeletionRandomDegreeNodeSampling struct {
+	IDeletionSamplingStrategy
+	Rand *rand.Rand
+}
+type DeletionRandomEdgeSampling struct {
+	IDeletionSamplingStrategy
+	Rand *rand.Rand
+}
+type DeletionRandomNodeEdgeSampling struct {
+	IDeletionSamplingStrategy
+	Rand *rand.Rand
+}
+type DeletionHybridSampling struct {
+	IDeletionSamplingStrategy
+	Rand *rand.Rand
+}
+type DeletionRandomWalkSampling struct {
+	IDeletionSamplingStrategy
+	Rand *rand.Rand
+}
+type DeletionRandomWalkWithJumpSampling struct {
+	IDeletionSamplingStrategy
+	Rand *rand.Rand
+}
+type DeletionRandomWalkWithRestartSampling struct {
+	IDeletionSamplingStrategy
+	Rand *rand.Rand
+}
 
 func (strategy *DeletionRandomNodeSampling) SamplingStage(g *UndirectedGraph, howMany int) error {
-	nodes := GetDictKeys(g.Nodes)
-	for _, node := range rand.Perm(len(nodes))[:howMany] {
+	random := randomOrDefault(strategy.Rand)
+	nodes := sortedNodes(g.Nodes)
+	for _, node := range random.Perm(len(nodes))[:howMany] {
 		g.RemoveNode(nodes[node])
 	}
 	return nil
 }
 
 func (strategy *DeletionRandomNodeNeighbourSampling) SamplingStage(g *UndirectedGraph, howManyToDelete int) error {
+	random := randomOrDefault(strategy.Rand)
 	for i := 0; i < howManyToDelete; i++ {
-		nodes := GetDictKeys(g.Nodes)
-		nodeIndex := rand.Perm(len(nodes))[0]
+		nodes := sortedNodes(g.Nodes)
+		nodeIndex := random.Perm(len(nodes))[0]
 		neighbours := g.Edges[nodes[nodeIndex]]
-		neighbourIndex := rand.Perm(len(neighbours))[0]
+		neighbourIndex := random.Perm(len(neighbours))[0]
 		g.RemoveNode(neighbours[neighbourIndex])
 	}
 	return nil
 }
 
 func (strategy *DeletionInclusiveRandomNodeNeighbourSampling) SamplingStage(g *UndirectedGraph, howManyToDelete int) error {
+	random := randomOrDefault(strategy.Rand)
 	for i := 0; i < howManyToDelete; i++ {
-		nodes := GetDictKeys(g.Nodes)
-		nodeIndex := rand.Perm(len(nodes))[0]
+		nodes := sortedNodes(g.Nodes)
+		nodeIndex := random.Perm(len(nodes))[0]
 		neighbours := g.Edges[nodes[nodeIndex]]
-		neighbourIndex := rand.Perm(len(neighbours))[0]
+		neighbourIndex := random.Perm(len(neighbours))[0]
 		g.RemoveNode(nodes[nodeIndex])
 		g.RemoveNode(neighbours[neighbourIndex])
 	}
@@ -72,16 +109,17 @@ func (strategy *DeletionInclusiveRandomNodeNeighbourSampling) SamplingStage(g *U
 }
 
 func (strategy *DeletionRandomDegreeNodeSampling) SamplingStage(g *UndirectedGraph, howManyToDelete int) error {
+	random := randomOrDefault(strategy.Rand)
 	for i := 0; i < howManyToDelete; i++ {
 		var choices []weightedrand.Choice
-		for node := range g.Nodes {
+		for _, node := range sortedNodes(g.Nodes) {
 			choices = append(choices, weightedrand.NewChoice(node, uint(len(g.Edges[node]))))
 		}
 		choice, err := weightedrand.NewChooser(choices...)
 		if err != nil {
 			return fmt.Errorf("error gettint new chooser: %w", err)
 		}
-		pick := choice.Pick()
+		pick := choice.PickSource(random)
 		nodeToRemove := pick.(Node)
 		g.RemoveNode(nodeToRemove)
 	}
@@ -89,41 +127,44 @@ func (strategy *DeletionRandomDegreeNodeSampling) SamplingStage(g *UndirectedGra
 }
 
 func (strategy *DeletionRandomEdgeSampling) SamplingStage(g *UndirectedGraph, howManyToDelete int) error {
-	edges := g.GetEdgeTuples()
+	random := randomOrDefault(strategy.Rand)
+	edges := sortedEdgeTuples(g)
 
-	for _, edgeIndex := range rand.Perm(len(edges))[:howManyToDelete] {
+	for _, edgeIndex := range random.Perm(len(edges))[:howManyToDelete] {
 		g.RemoveEdge(edges[edgeIndex])
 	}
 	return nil
 }
 
 func (strategy *DeletionRandomNodeEdgeSampling) SamplingStage(g *UndirectedGraph, howManyToDelete int) error {
-	edges := g.GetEdgeTuples()
-	nodes := GetDictKeys(g.Nodes)
+	random := randomOrDefault(strategy.Rand)
+	edges := sortedEdgeTuples(g)
+	nodes := sortedNodes(g.Nodes)
 
-	for _, nodeIndex := range rand.Perm(len(nodes))[:howManyToDelete] {
+	for _, nodeIndex := range random.Perm(len(nodes))[:howManyToDelete] {
 		nodeEdges := g.Edges[nodes[nodeIndex]]
-		edgeIndex := rand.Perm(len(nodeEdges))[0]
+		edgeIndex := random.Perm(len(nodeEdges))[0]
 		g.RemoveEdge(edges[edgeIndex])
 	}
 	return nil
 }
 
 func (strategy *DeletionHybridSampling) SamplingStage(g *UndirectedGraph, howManyToDelete int) error {
+	random := randomOrDefault(strategy.Rand)
 	w := float32(0.42) // TODO update
-	edges := g.GetEdgeTuples()
+	edges := sortedEdgeTuples(g)
 
-	nodes := GetDictKeys(g.Nodes)
+	nodes := sortedNodes(g.Nodes)
 
 	for i := 0; i < howManyToDelete; i++ {
-		if rand.Float32() < w {
-			for _, nodeIndex := range rand.Perm(len(nodes))[:1] {
+		if random.Float32() < w {
+			for _, nodeIndex := range random.Perm(len(nodes))[:1] {
 				nodeEdges := g.Edges[nodes[nodeIndex]]
-				edgeIndex := rand.Perm(len(nodeEdges))[0]
+				edgeIndex := random.Perm(len(nodeEdges))[0]
 				g.RemoveEdge(edges[edgeIndex])
 			}
 		} else {
-			edgeIndex := rand.Perm(len(edges))[0]
+			edgeIndex := random.Perm(len(edges))[0]
 			g.RemoveEdge(edges[edgeIndex])
 		}
 	}
@@ -131,13 +172,14 @@ func (strategy *DeletionHybridSampling) SamplingStage(g *UndirectedGraph, howMan
 }
 
 func (strategy *DeletionRandomWalkSampling) SamplingStage(g *UndirectedGraph, howManyToDelete int) error {
-	startNode := g.pickRandomNode()
+	random := randomOrDefault(strategy.Rand)
+	startNode := g.pickRandomNode(random)
 	currentNode := startNode
 
 	for i := 0; i < howManyToDelete; i++ {
 		neighbors := g.Edges[currentNode]
 		if len(neighbors) > 0 {
-			nextNode := neighbors[rand.Intn(len(neighbors))]
+			nextNode := neighbors[random.Intn(len(neighbors))]
 			g.RemoveNode(currentNode)
 			// c value taken from Leskovec, Jure, and Christos Faloutsos. "Sampling from large graphs." Proceedings of the 12th ACM SIGKDD international conference on Knowledge discovery and data mining. 2006.
 			currentNode = nextNode
@@ -150,49 +192,51 @@ func (strategy *DeletionRandomWalkSampling) SamplingStage(g *UndirectedGraph, ho
 }
 
 func (strategy *DeletionRandomWalkWithRestartSampling) SamplingStage(g *UndirectedGraph, howManyToDelete int) error {
-	startNode := g.pickRandomNode()
+	random := randomOrDefault(strategy.Rand)
+	startNode := g.pickRandomNode(random)
 	neighbors := g.Edges[startNode]
-	nodeToInclude := neighbors[rand.Intn(len(neighbors))]
+	nodeToInclude := neighbors[random.Intn(len(neighbors))]
 
 	for i := 0; i < howManyToDelete; i++ {
 		neighbors := g.Edges[nodeToInclude]
 		if len(neighbors) > 0 {
-			nextNode := neighbors[rand.Intn(len(neighbors))]
+			nextNode := neighbors[random.Intn(len(neighbors))]
 			g.RemoveNode(nodeToInclude)
 			// c value taken from Leskovec, Jure, and Christos Faloutsos. "Sampling from large graphs." Proceedings of the 12th ACM SIGKDD international conference on Knowledge discovery and data mining. 2006.
-			if rand.Float32() < 0.15 {
+			if random.Float32() < 0.15 {
 				neighbors = g.Edges[startNode]
-				nodeToInclude = neighbors[rand.Intn(len(neighbors))]
+				nodeToInclude = neighbors[random.Intn(len(neighbors))]
 			} else {
 				nodeToInclude = nextNode
 			}
 		} else {
 			// If the current node has no neighbors, go to first node
 			neighbors = g.Edges[startNode]
-			nodeToInclude = neighbors[rand.Intn(len(neighbors))]
+			nodeToInclude = neighbors[random.Intn(len(neighbors))]
 		}
 	}
 	return nil
 }
 
 func (strategy *DeletionRandomWalkWithJumpSampling) SamplingStage(g *UndirectedGraph, howManyToDelete int) error {
-	startNode := g.pickRandomNode()
+	random := randomOrDefault(strategy.Rand)
+	startNode := g.pickRandomNode(random)
 	currentNode := startNode
 
 	for i := 0; i < howManyToDelete; i++ {
 		neighbors := g.Edges[currentNode]
 		if len(neighbors) > 0 {
-			nextNode := neighbors[rand.Intn(len(neighbors))]
+			nextNode := neighbors[random.Intn(len(neighbors))]
 			g.RemoveNode(currentNode)
 			// c value taken from Leskovec, Jure, and Christos Faloutsos. "Sampling from large graphs." Proceedings of the 12th ACM SIGKDD international conference on Knowledge discovery and data mining. 2006.
-			if rand.Float32() < 0.15 {
-				currentNode = g.pickRandomNode()
+			if random.Float32() < 0.15 {
+				currentNode = g.pickRandomNode(random)
 			} else {
 				currentNode = nextNode
 			}
 		} else {
 			// If the current node has no neighbors, jump to random node
-			currentNode = g.pickRandomNode()
+			currentNode = g.pickRandomNode(random)
 		}
 	}
 	return nil
@@ -225,30 +269,61 @@ func (strategy *DeletionSamplingStrategy) Sample(graph *UndirectedGraph, sampled
 	PRESERVATION GRAPH SAMPLING METHODS
 */
 
-type PreservationRandomNodeSampling struct{ ISamplingStrategy }
-type PreservationRandomNodeNeighbourSampling struct{ ISamplingStrategy }
-type PreservationInclusiveRandomNodeNeighbourSampling struct{ ISamplingStrategy }
-type PreservationRandomDegreeNodeSampling struct{ ISamplingStrategy }
+type PreservationRandomNodeSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type PreservationRandomNodeNeighbourSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type PreservationInclusiveRandomNodeNeighbourSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type PreservationRandomDegreeNodeSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
 type PreservationNodeSamplingWithContraction struct{ ISamplingStrategy }
 type RandomPageRankNodeSampling struct{ ISamplingStrategy }
-type PreservationRandomEdgeSampling struct{ ISamplingStrategy }
-type PreservationRandomNodeEdgeSampling struct{ ISamplingStrategy }
-type PreservationHybridSampling struct{ ISamplingStrategy }
-type PreservationRandomWalkSampling struct{ ISamplingStrategy }
-type PreservationRandomWalkWithJumpSampling struct{ ISamplingStrategy }
-type PreservationRandomWalkWithRestartSampling struct{ ISamplingStrategy }
+type PreservationRandomEdgeSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type PreservationRandomNodeEdgeSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type PreservationHybridSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type PreservationRandomWalkSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type PreservationRandomWalkWithJumpSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type PreservationRandomWalkWithRestartSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
 type PreservationTopKEdgeSampling struct{ ISamplingStrategy }
 
 func (strategy *PreservationRandomNodeSampling) Sample(g UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{
 		Nodes: make(map[Node]bool),
 		Edges: make(map[Node][]Node),
 	}
 	expectedFinalGraphSize := int(float32(len(g.Nodes)) * sampledGraphSizeRatio)
-	nodes := GetDictKeys(g.Nodes)
+	nodes := sortedNodes(g.Nodes)
 	var selectedNodes []Node
 
-	for _, node := range rand.Perm(len(nodes))[:expectedFinalGraphSize] {
+	for _, node := range random.Perm(len(nodes))[:expectedFinalGraphSize] {
 		ng.AddNode(nodes[node])
 		selectedNodes = append(selectedNodes, nodes[node])
 	}
@@ -265,6 +340,7 @@ func (strategy *PreservationRandomNodeSampling) Sample(g UndirectedGraph, sample
 }
 
 func (strategy *PreservationRandomNodeNeighbourSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{
 		Nodes: make(map[Node]bool),
 		Edges: make(map[Node][]Node),
@@ -273,16 +349,17 @@ func (strategy *PreservationRandomNodeNeighbourSampling) Sample(graph Undirected
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
 
 	for len(ng.Nodes) <= expectedFinalGraphSize {
-		nodes := GetDictKeys(graph.Nodes)
-		nodeIndex := rand.Perm(len(nodes))[0]
+		nodes := sortedNodes(graph.Nodes)
+		nodeIndex := random.Perm(len(nodes))[0]
 		neighbours := graph.Edges[nodes[nodeIndex]]
-		neighbourIndex := rand.Perm(len(neighbours))[0]
+		neighbourIndex := random.Perm(len(neighbours))[0]
 		ng.AddNode(neighbours[neighbourIndex])
 	}
 	return ng, nil
 }
 
 func (strategy *PreservationInclusiveRandomNodeNeighbourSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{
 		Nodes: make(map[Node]bool),
 		Edges: make(map[Node][]Node),
@@ -291,10 +368,10 @@ func (strategy *PreservationInclusiveRandomNodeNeighbourSampling) Sample(graph U
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
 
 	for len(ng.Nodes) <= expectedFinalGraphSize {
-		nodes := GetDictKeys(graph.Nodes)
-		nodeIndex := rand.Perm(len(nodes))[0]
+		nodes := sortedNodes(graph.Nodes)
+		nodeIndex := random.Perm(len(nodes))[0]
 		neighbours := graph.Edges[nodes[nodeIndex]]
-		neighbourIndex := rand.Perm(len(neighbours))[0]
+		neighbourIndex := random.Perm(len(neighbours))[0]
 		ng.AddNode(nodes[nodeIndex])
 		ng.AddNode(neighbours[neighbourIndex])
 	}
@@ -302,6 +379,7 @@ func (strategy *PreservationInclusiveRandomNodeNeighbourSampling) Sample(graph U
 }
 
 func (strategy *PreservationRandomDegreeNodeSampling) Sample(g UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{
 		Nodes: make(map[Node]bool),
 		Edges: make(map[Node][]Node),
@@ -313,7 +391,7 @@ func (strategy *PreservationRandomDegreeNodeSampling) Sample(g UndirectedGraph,
 	nodesCounter := 0
 	for {
 		var choices []weightedrand.Choice
-		for node := range g.Nodes {
+		for _, node := range sortedNodes(g.Nodes) {
 			choices = append(choices, weightedrand.NewChoice(node, uint(len(g.Edges[node]))))
 		}
 
@@ -324,7 +402,7 @@ func (strategy *PreservationRandomDegreeNodeSampling) Sample(g UndirectedGraph,
 				Edges: nil,
 			}, fmt.Errorf("error gettint new chooser: %w", err)
 		}
-		pick := choice.Pick()
+		pick := choice.PickSource(random)
 		if !selectedNodes[pick.(Node)] {
 			ng.AddNode(pick.(Node))
 			selectedNodes[pick.(Node)] = true
@@ -348,16 +426,17 @@ func (strategy *PreservationRandomDegreeNodeSampling) Sample(g UndirectedGraph,
 }
 
 func (strategy *PreservationRandomEdgeSampling) Sample(g UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{
 		Nodes: make(map[Node]bool),
 		Edges: make(map[Node][]Node),
 	}
 	expectedFinalGraphSize := int(float32(len(g.Nodes)) * sampledGraphSizeRatio)
 
-	edges := g.GetEdgeTuples()
+	edges := sortedEdgeTuples(&g)
 	nodes := map[Node]bool{}
 
-	for _, edgeIndex := range rand.Perm(len(edges)) {
+	for _, edgeIndex := range random.Perm(len(edges)) {
 		ng.AddEdge(edges[edgeIndex])
 		nodes[edges[edgeIndex].Node1] = true
 		nodes[edges[edgeIndex].Node2] = true
@@ -369,19 +448,20 @@ func (strategy *PreservationRandomEdgeSampling) Sample(g UndirectedGraph, sample
 }
 
 func (strategy *PreservationRandomNodeEdgeSampling) Sample(g UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{
 		Nodes: map[Node]bool{},
 		Edges: map[Node][]Node{},
 	}
 	expectedFinalGraphSize := int(float32(len(g.Nodes)) * sampledGraphSizeRatio)
-	edges := g.GetEdgeTuples()
+	edges := sortedEdgeTuples(&g)
 
-	nodes := GetDictKeys(g.Nodes)
+	nodes := sortedNodes(g.Nodes)
 	newNodes := map[Node]bool{}
 
-	for _, nodeIndex := range rand.Perm(len(nodes)) {
+	for _, nodeIndex := range random.Perm(len(nodes)) {
 		nodeEdges := g.Edges[nodes[nodeIndex]]
-		for _, edgeIndex := range rand.Perm(len(nodeEdges))[:1] {
+		for _, edgeIndex := range random.Perm(len(nodeEdges))[:1] {
 			ng.AddEdge(edges[edgeIndex])
 			newNodes[edges[edgeIndex].Node1] = true
 			newNodes[edges[edgeIndex].Node2] = true
@@ -394,29 +474,30 @@ func (strategy *PreservationRandomNodeEdgeSampling) Sample(g UndirectedGraph, sa
 }
 
 func (strategy *PreservationHybridSampling) Sample(graph *UndirectedGraph, sampledGraphSizeRatio float32) (*UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	w := float32(0.5)
 	ng := &UndirectedGraph{
 		Nodes: map[Node]bool{},
 		Edges: map[Node][]Node{},
 	}
 
-	edges := graph.GetEdgeTuples()
+	edges := sortedEdgeTuples(graph)
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
-	nodes := GetDictKeys(graph.Nodes)
+	nodes := sortedNodes(graph.Nodes)
 	newNodes := map[Node]bool{}
 
 	for {
-		if rand.Float32() < w {
-			for _, nodeIndex := range rand.Perm(len(nodes))[:1] {
+		if random.Float32() < w {
+			for _, nodeIndex := range random.Perm(len(nodes))[:1] {
 				nodeEdges := graph.Edges[nodes[nodeIndex]]
-				for _, edgeIndex := range rand.Perm(len(nodeEdges))[:1] {
+				for _, edgeIndex := range random.Perm(len(nodeEdges))[:1] {
 					ng.AddEdge(edges[edgeIndex])
 					newNodes[edges[edgeIndex].Node1] = true
 					newNodes[edges[edgeIndex].Node2] = true
 				}
 			}
 		} else {
-			for _, edgeIndex := range rand.Perm(len(edges)) {
+			for _, edgeIndex := range random.Perm(len(edges)) {
 				ng.AddEdge(edges[edgeIndex])
 				newNodes[edges[edgeIndex].Node1] = true
 				newNodes[edges[edgeIndex].Node2] = true
@@ -430,19 +511,20 @@ func (strategy *PreservationHybridSampling) Sample(graph *UndirectedGraph, sampl
 }
 
 func (strategy *PreservationRandomWalkSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{
 		Nodes: map[Node]bool{},
 		Edges: map[Node][]Node{},
 	}
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
 
-	startNode := graph.pickRandomNode()
+	startNode := graph.pickRandomNode(random)
 	currentNode := startNode
 
 	for {
 		neighbors := graph.Edges[currentNode]
 		if len(neighbors) > 0 {
-			nextNode := neighbors[rand.Intn(len(neighbors))]
+			nextNode := neighbors[random.Intn(len(neighbors))]
 			ng.AddNode(currentNode)
 			// c value taken from Leskovec, Jure, and Christos Faloutsos. "Sampling from large graphs." Proceedings of the 12th ACM SIGKDD international conference on Knowledge discovery and data mining. 2006.
 			currentNode = nextNode
@@ -458,32 +540,33 @@ func (strategy *PreservationRandomWalkSampling) Sample(graph UndirectedGraph, sa
 }
 
 func (strategy *PreservationRandomWalkWithRestartSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{
 		Nodes: map[Node]bool{},
 		Edges: map[Node][]Node{},
 	}
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
 
-	startNode := graph.pickRandomNode()
+	startNode := graph.pickRandomNode(random)
 	neighbors := graph.Edges[startNode]
-	nodeToInclude := neighbors[rand.Intn(len(neighbors))]
+	nodeToInclude := neighbors[random.Intn(len(neighbors))]
 
 	for {
 		neighbors := graph.Edges[nodeToInclude]
 		if len(neighbors) > 0 {
-			nextNode := neighbors[rand.Intn(len(neighbors))]
+			nextNode := neighbors[random.Intn(len(neighbors))]
 			ng.AddNode(nodeToInclude)
 			// c value taken from Leskovec, Jure, and Christos Faloutsos. "Sampling from large graphs." Proceedings of the 12th ACM SIGKDD international conference on Knowledge discovery and data mining. 2006.
-			if rand.Float32() < 0.15 {
+			if random.Float32() < 0.15 {
 				neighbors = graph.Edges[startNode]
-				nodeToInclude = neighbors[rand.Intn(len(neighbors))]
+				nodeToInclude = neighbors[random.Intn(len(neighbors))]
 			} else {
 				nodeToInclude = nextNode
 			}
 		} else {
 			// If the current node has no neighbors, go to first node
 			neighbors = graph.Edges[startNode]
-			nodeToInclude = neighbors[rand.Intn(len(neighbors))]
+			nodeToInclude = neighbors[random.Intn(len(neighbors))]
 		}
 		if expectedFinalGraphSize <= len(ng.Nodes) {
 			break
@@ -493,29 +576,30 @@ func (strategy *PreservationRandomWalkWithRestartSampling) Sample(graph Undirect
 }
 
 func (strategy *PreservationRandomWalkWithJumpSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{
 		Nodes: map[Node]bool{},
 		Edges: map[Node][]Node{},
 	}
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
 
-	startNode := graph.pickRandomNode()
+	startNode := graph.pickRandomNode(random)
 	currentNode := startNode
 
 	for {
 		neighbors := graph.Edges[currentNode]
 		if len(neighbors) > 0 {
-			nextNode := neighbors[rand.Intn(len(neighbors))]
+			nextNode := neighbors[random.Intn(len(neighbors))]
 			ng.AddNode(currentNode)
 			// c value taken from Leskovec, Jure, and Christos Faloutsos. "Sampling from large graphs." Proceedings of the 12th ACM SIGKDD international conference on Knowledge discovery and data mining. 2006.
-			if rand.Float32() < 0.15 {
-				currentNode = ng.pickRandomNode()
+			if random.Float32() < 0.15 {
+				currentNode = ng.pickRandomNode(random)
 			} else {
 				currentNode = nextNode
 			}
 		} else {
 			// If the current node has no neighbors, jump to random node
-			currentNode = graph.pickRandomNode()
+			currentNode = graph.pickRandomNode(random)
 		}
 		if expectedFinalGraphSize <= len(ng.Nodes) {
 			break
@@ -560,20 +644,51 @@ func (strategy *PreservationTopKEdgeSampling) Sample(g UndirectedGraph, sampledG
 	CONTRACTION GRAPH SAMPLING METHODS
 */
 
-type ContractionRandomNodeSampling struct{ ISamplingStrategy }
-type ContractionRandomNodeNeighbourSampling struct{ ISamplingStrategy }
-type ContractionInclusiveRandomNodeNeighbourSampling struct{ ISamplingStrategy }
-type ContractionRandomDegreeNodeSampling struct{ ISamplingStrategy }
-type ContractionRandomEdgeSampling struct{ ISamplingStrategy }
-type ContractionRandomNodeEdgeSampling struct{ ISamplingStrategy }
-type ContractionHybridSampling struct{ ISamplingStrategy }
-type ContractionRandomWalkSampling struct{ ISamplingStrategy }
-type ContractionRandomWalkWithRestartSampling struct{ ISamplingStrategy }
-type ContractionRandomWalkWithJumpSampling struct{ ISamplingStrategy }
+type ContractionRandomNodeSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type ContractionRandomNodeNeighbourSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type ContractionInclusiveRandomNodeNeighbourSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type ContractionRandomDegreeNodeSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type ContractionRandomEdgeSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type ContractionRandomNodeEdgeSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type ContractionHybridSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type ContractionRandomWalkSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type ContractionRandomWalkWithRestartSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
+type ContractionRandomWalkWithJumpSampling struct {
+	ISamplingStrategy
+	Rand *rand.Rand
+}
 
 type ContractionPageRankNodeSampling struct{ ISamplingStrategy }
 
 func (strategy *ContractionRandomNodeSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{}
 	// deep copy
 	err := copier.Copy(ng, graph)
@@ -584,8 +699,8 @@ func (strategy *ContractionRandomNodeSampling) Sample(graph UndirectedGraph, sam
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
 
 	for len(ng.Nodes) <= expectedFinalGraphSize {
-		nodes := GetDictKeys(graph.Nodes)
-		for _, node := range rand.Perm(len(nodes))[:1] {
+		nodes := sortedNodes(graph.Nodes)
+		for _, node := range random.Perm(len(nodes))[:1] {
 			graph.ContractNode(nodes[node])
 		}
 	}
@@ -593,6 +708,7 @@ func (strategy *ContractionRandomNodeSampling) Sample(graph UndirectedGraph, sam
 }
 
 func (strategy *ContractionRandomNodeNeighbourSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{}
 	// deep copy
 	err := copier.Copy(ng, graph)
@@ -603,16 +719,17 @@ func (strategy *ContractionRandomNodeNeighbourSampling) Sample(graph UndirectedG
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
 
 	for len(ng.Nodes) <= expectedFinalGraphSize {
-		nodes := GetDictKeys(graph.Nodes)
-		nodeIndex := rand.Perm(len(nodes))[0]
+		nodes := sortedNodes(graph.Nodes)
+		nodeIndex := random.Perm(len(nodes))[0]
 		neighbours := ng.Edges[Node(nodes[nodeIndex])]
-		neighbourIndex := rand.Perm(len(neighbours))[0]
+		neighbourIndex := random.Perm(len(neighbours))[0]
 		ng.ContractNode(neighbours[neighbourIndex])
 	}
 	return ng, nil
 }
 
 func (strategy *ContractionInclusiveRandomNodeNeighbourSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{}
 	// deep copy
 	err := copier.Copy(ng, graph)
@@ -623,10 +740,10 @@ func (strategy *ContractionInclusiveRandomNodeNeighbourSampling) Sample(graph Un
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
 
 	for len(ng.Nodes) <= expectedFinalGraphSize {
-		nodes := GetDictKeys(graph.Nodes)
-		nodeIndex := rand.Perm(len(nodes))[0]
+		nodes := sortedNodes(graph.Nodes)
+		nodeIndex := random.Perm(len(nodes))[0]
 		neighbours := ng.Edges[Node(nodes[nodeIndex])]
-		neighbourIndex := rand.Perm(len(neighbours))[0]
+		neighbourIndex := random.Perm(len(neighbours))[0]
 		ng.ContractNode(nodes[nodeIndex])
 		ng.ContractNode(neighbours[neighbourIndex])
 	}
@@ -634,6 +751,7 @@ func (strategy *ContractionInclusiveRandomNodeNeighbourSampling) Sample(graph Un
 }
 
 func (strategy *ContractionRandomDegreeNodeSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{}
 	// deep copy
 	err := copier.Copy(ng, graph)
@@ -645,14 +763,14 @@ func (strategy *ContractionRandomDegreeNodeSampling) Sample(graph UndirectedGrap
 
 	for len(ng.Nodes) <= expectedFinalGraphSize {
 		var choices []weightedrand.Choice
-		for node := range ng.Nodes {
+		for _, node := range sortedNodes(ng.Nodes) {
 			choices = append(choices, weightedrand.NewChoice(node, uint(len(ng.Edges[node]))))
 		}
 		choice, err := weightedrand.NewChooser(choices...)
 		if err != nil {
 			return ng, fmt.Errorf("error gettint new chooser: %w", err)
 		}
-		pick := choice.Pick()
+		pick := choice.PickSource(random)
 		nodeToContract := pick.(Node)
 		graph.ContractNode(nodeToContract)
 	}
@@ -660,6 +778,7 @@ func (strategy *ContractionRandomDegreeNodeSampling) Sample(graph UndirectedGrap
 }
 
 func (strategy *ContractionRandomEdgeSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{}
 	// deep copy
 	err := copier.Copy(ng, graph)
@@ -669,10 +788,10 @@ func (strategy *ContractionRandomEdgeSampling) Sample(graph UndirectedGraph, sam
 
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
 
-	edges := graph.GetEdgeTuples()
+	edges := sortedEdgeTuples(&graph)
 	nodes := map[Node]bool{}
 
-	for _, edgeIndex := range rand.Perm(len(edges)) {
+	for _, edgeIndex := range random.Perm(len(edges)) {
 		ng.ContractEdge(edges[edgeIndex])
 		if expectedFinalGraphSize <= len(nodes) {
 			break
@@ -682,6 +801,7 @@ func (strategy *ContractionRandomEdgeSampling) Sample(graph UndirectedGraph, sam
 }
 
 func (strategy *ContractionRandomNodeEdgeSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{}
 	// deep copy
 	err := copier.Copy(ng, graph)
@@ -690,14 +810,14 @@ func (strategy *ContractionRandomNodeEdgeSampling) Sample(graph UndirectedGraph,
 	}
 
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
-	edges := graph.GetEdgeTuples()
+	edges := sortedEdgeTuples(&graph)
 
-	nodes := GetDictKeys(graph.Nodes)
+	nodes := sortedNodes(graph.Nodes)
 	newNodes := map[Node]bool{}
 
-	for _, nodeIndex := range rand.Perm(len(nodes)) {
+	for _, nodeIndex := range random.Perm(len(nodes)) {
 		nodeEdges := graph.Edges[nodes[nodeIndex]]
-		for _, edgeIndex := range rand.Perm(len(nodeEdges))[:1] {
+		for _, edgeIndex := range random.Perm(len(nodeEdges))[:1] {
 			ng.ContractEdge(edges[edgeIndex])
 			if expectedFinalGraphSize <= len(newNodes) {
 				break
@@ -708,6 +828,7 @@ func (strategy *ContractionRandomNodeEdgeSampling) Sample(graph UndirectedGraph,
 }
 
 func (strategy *ContractionHybridSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{}
 	// deep copy
 	err := copier.Copy(ng, graph)
@@ -716,21 +837,21 @@ func (strategy *ContractionHybridSampling) Sample(graph UndirectedGraph, sampled
 	}
 	w := float32(0.5)
 
-	edges := graph.GetEdgeTuples()
+	edges := sortedEdgeTuples(&graph)
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
-	nodes := GetDictKeys(graph.Nodes)
+	nodes := sortedNodes(graph.Nodes)
 	newNodes := map[Node]bool{}
 
 	for {
-		if rand.Float32() < w {
-			for _, nodeIndex := range rand.Perm(len(nodes))[:1] {
+		if random.Float32() < w {
+			for _, nodeIndex := range random.Perm(len(nodes))[:1] {
 				nodeEdges := graph.Edges[nodes[nodeIndex]]
-				for _, edgeIndex := range rand.Perm(len(nodeEdges))[:1] {
+				for _, edgeIndex := range random.Perm(len(nodeEdges))[:1] {
 					ng.ContractEdge(edges[edgeIndex])
 				}
 			}
 		} else {
-			for _, edgeIndex := range rand.Perm(len(edges)) {
+			for _, edgeIndex := range random.Perm(len(edges)) {
 				ng.ContractEdge(edges[edgeIndex])
 			}
 		}
@@ -742,6 +863,7 @@ func (strategy *ContractionHybridSampling) Sample(graph UndirectedGraph, sampled
 }
 
 func (strategy *ContractionRandomWalkSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{}
 	// deep copy
 	err := copier.Copy(ng, graph)
@@ -750,13 +872,13 @@ func (strategy *ContractionRandomWalkSampling) Sample(graph UndirectedGraph, sam
 	}
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
 
-	startNode := ng.pickRandomNode()
+	startNode := ng.pickRandomNode(random)
 	currentNode := startNode
 
 	for {
 		neighbors := graph.Edges[currentNode]
 		if len(neighbors) > 0 {
-			nextNode := neighbors[rand.Intn(len(neighbors))]
+			nextNode := neighbors[random.Intn(len(neighbors))]
 			ng.ContractNode(currentNode)
 			// c value taken from Leskovec, Jure, and Christos Faloutsos. "Sampling from large graphs." Proceedings of the 12th ACM SIGKDD international conference on Knowledge discovery and data mining. 2006.
 			currentNode = nextNode
@@ -772,6 +894,7 @@ func (strategy *ContractionRandomWalkSampling) Sample(graph UndirectedGraph, sam
 }
 
 func (strategy *ContractionRandomWalkWithRestartSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{}
 	// deep copy
 	err := copier.Copy(ng, graph)
@@ -780,26 +903,26 @@ func (strategy *ContractionRandomWalkWithRestartSampling) Sample(graph Undirecte
 	}
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
 
-	startNode := ng.pickRandomNode()
+	startNode := ng.pickRandomNode(random)
 	neighbors := graph.Edges[startNode]
-	contractNode := neighbors[rand.Intn(len(neighbors))]
+	contractNode := neighbors[random.Intn(len(neighbors))]
 
 	for {
 		neighbors := graph.Edges[contractNode]
 		if len(neighbors) > 0 {
-			nextNode := neighbors[rand.Intn(len(neighbors))]
+			nextNode := neighbors[random.Intn(len(neighbors))]
 			ng.ContractNode(contractNode)
 			// c value taken from Leskovec, Jure, and Christos Faloutsos. "Sampling from large graphs." Proceedings of the 12th ACM SIGKDD international conference on Knowledge discovery and data mining. 2006.
-			if rand.Float32() < 0.15 {
+			if random.Float32() < 0.15 {
 				neighbors = graph.Edges[startNode]
-				contractNode = neighbors[rand.Intn(len(neighbors))]
+				contractNode = neighbors[random.Intn(len(neighbors))]
 			} else {
 				contractNode = nextNode
 			}
 		} else {
 			// If the current node has no neighbors, go to first node
 			neighbors = graph.Edges[startNode]
-			contractNode = neighbors[rand.Intn(len(neighbors))]
+			contractNode = neighbors[random.Intn(len(neighbors))]
 		}
 		if expectedFinalGraphSize <= len(ng.Nodes) {
 			break
@@ -809,6 +932,7 @@ func (strategy *ContractionRandomWalkWithRestartSampling) Sample(graph Undirecte
 }
 
 func (strategy *ContractionRandomWalkWithJumpSampling) Sample(graph UndirectedGraph, sampledGraphSizeRatio float32) (UndirectedGraph, error) {
+	random := randomOrDefault(strategy.Rand)
 	ng := UndirectedGraph{}
 	// deep copy
 	err := copier.Copy(ng, graph)
@@ -817,24 +941,24 @@ func (strategy *ContractionRandomWalkWithJumpSampling) Sample(graph UndirectedGr
 	}
 	expectedFinalGraphSize := int(float32(len(graph.Nodes)) * sampledGraphSizeRatio)
 
-	startNode := ng.pickRandomNode()
+	startNode := ng.pickRandomNode(random)
 	currentNode := startNode
 
 	for {
 		neighbors := graph.Edges[currentNode]
 		if len(neighbors) > 0 {
-			nextNode := neighbors[rand.Intn(len(neighbors))]
+			nextNode := neighbors[random.Intn(len(neighbors))]
 			ng.ContractNode(currentNode)
 			// c value taken from Leskovec, Jure, and Christos Faloutsos. "Sampling from large graphs." Proceedings of the 12th ACM SIGKDD international conference on Knowledge discovery and data mining. 2006.
-			if rand.Float32() < 0.15 {
-				currentNode = ng.pickRandomNode()
+			if random.Float32() < 0.15 {
+				currentNode = ng.pickRandomNode(random)
 			} else {
 				currentNode = nextNode
 			}
 
 		} else {
 			// If the current node has no neighbors, jump to random node
-			currentNode = ng.pickRandomNode()
+			currentNode = ng.pickRandomNode(random)
 		}
 		if expectedFinalGraphSize <= len(ng.Nodes) {
 			break
@@ -844,10 +968,15 @@ func (strategy *ContractionRandomWalkWithJumpSampling) Sample(graph UndirectedGr
 }
 
 // Helper method to pick a random node from the graph
-func (g *UndirectedGraph) pickRandomNode() Node {
-	var nodes []Node
-	for node := range g.Nodes {
-		nodes = append(nodes, node)
-	}
-	return nodes[rand.Intn(len(nodes))]
+func (g *UndirectedGraph) pickRandomNode(random *rand.Rand) Node {
+	nodes := sortedNodes(g.Nodes)
+	return nodes[random.Intn(len(nodes))]
+}
+
+// sortedEdgeTuples returns the edge tuples of the graph in increasing order, so that
+// picking edges by random index only depends on the source of randomness.
+func sortedEdgeTuples(g *UndirectedGraph) []Edge {
+	edges := g.GetEdgeTuples()
+	sortEdges(edges)
+	return edges
 }
@@ -0,0 +1,372 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+/*
+SimRank computes the SimRank similarity of every pair of nodes.
+
+Parameters:
+- g: The undirected graph.
+- importance: The decay factor C, strictly between 0 and 1 (0.8 in the original paper).
+- maxIterations: The maximum number of iterations.
+- tolerance: The iteration stops when no score changes by more than tolerance.
+
+Returns:
+- similarity: similarity[u][v] is the SimRank score of u and v; every node has similarity 1 with itself and pairs with a zero score are omitted.
+- error: If importance is not in (0, 1) or maxIterations is negative.
+
+Description:
+Two nodes are similar when their neighbours are similar:
+s(u, v) = C / (|N(u)|·|N(v)|) · Σ s(a, b) over a in N(u) and b in N(v).
+The iterative computation of Jeh and Widom takes O(k·V²·d²) time, where d is
+the average degree, which limits it to graphs of a few thousand nodes; see
+MonteCarloSimRank for larger graphs. Self-loops and parallel edges are ignored.
+*/
+func SimRank(g *UndirectedGraph, importance float64, maxIterations int, tolerance float64) (map[Node]map[Node]float64, error) {
+	if importance <= 0 || importance >= 1 {
+		return nil, fmt.Errorf("importance factor must be in (0, 1), got %v", importance)
+	}
+	if maxIterations < 0 {
+		return nil, fmt.Errorf("the number of iterations must not be negative, got %d", maxIterations)
+	}
+
+	nodes, _, adjacency := simpleAdjacency(g)
+	n := len(nodes)
+	scores := make([][]float64, n)
+	for v := range scores {
+		scores[v] = make([]float64, n)
+		scores[v][v] = 1
+	}
+
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		next := make([][]float64, n)
+		change := 0.0
+		for u := range next {
+			next[u] = make([]float64, n)
+			next[u][u] = 1
+		}
+		for u := 0; u < n; u++ {
+			for v := u + 1; v < n; v++ {
+				if len(adjacency[u]) == 0 || len(adjacency[v]) == 0 {
+					continue
+				}
+				sum := 0.0
+				for _, a := range adjacency[u] {
+					for _, b := range adjacency[v] {
+						sum += scores[a][b]
+					}
+				}
+				score := importance * sum / float64(len(adjacency[u])*len(adjacency[v]))
+				next[u][v], next[v][u] = score, score
+				change = math.Max(change, math.Abs(score-scores[u][v]))
+			}
+		}
+		scores = next
+		if change <= tolerance {
+			break
+		}
+	}
+
+	similarity := make(map[Node]map[Node]float64, n)
+	for u := range nodes {
+		similarity[nodes[u]] = make(map[Node]float64)
+		for v, score := range scores[u] {
+			if score > 0 {
+				similarity[nodes[u]][nodes[v]] = score
+			}
+		}
+	}
+	return similarity, nil
+}
+
+/*
+MonteCarloSimRank estimates the SimRank similarity of a node to every other node.
+
+Parameters:
+- g: The undirected graph.
+- source: The node whose similarities are estimated.
+- importance: The decay factor C, strictly between 0 and 1.
+- numberOfWalks: The number of random walks sampled from every node; the standard error decreases as 1/sqrt(numberOfWalks).
+- walkLength: The maximum length of each walk; meetings after walkLength steps are ignored, which truncates scores by at most C^walkLength.
+- options: Optional source of randomness, e.g. WithSeed(42) for reproducible estimates.
+
+Returns:
+- similarity: similarity[v] estimates the SimRank score of source and v; nodes with a zero estimate are omitted.
+- error: If source is not in the graph or a parameter is out of range.
+
+Description:
+SimRank equals E[C^τ], where τ is the first time two random walks started at
+the two nodes meet. Following Fogaras and Rácz, the function samples walks
+from every node and averages C^τ over pairs of walks sharing the same index,
+which takes O(V·numberOfWalks·walkLength) time.
+*/
+func MonteCarloSimRank(g *UndirectedGraph, source Node, importance float64, numberOfWalks int, walkLength int, options ...RandomOption) (map[Node]float64, error) {
+	if !g.HasNode(source) {
+		return nil, fmt.Errorf("node %d is not in the graph", source)
+	}
+	if importance <= 0 || importance >= 1 {
+		return nil, fmt.Errorf("importance factor must be in (0, 1), got %v", importance)
+	}
+	if numberOfWalks < 1 || walkLength < 1 {
+		return nil, fmt.Errorf("the number and length of walks must be positive, got %d and %d", numberOfWalks, walkLength)
+	}
+
+	random := newRandom(options...)
+	nodes, index, adjacency := simpleAdjacency(g)
+	randomWalk := func(start int) []int {
+		walk := []int{start}
+		for step := 0; step < walkLength && len(adjacency[walk[len(walk)-1]]) > 0; step++ {
+			neighbours := adjacency[walk[len(walk)-1]]
+			walk = append(walk, neighbours[random.Intn(len(neighbours))])
+		}
+		return walk
+	}
+
+	totals := make([]float64, len(nodes))
+	s := index[source]
+	for i := 0; i < numberOfWalks; i++ {
+		sourceWalk := randomWalk(s)
+		for v := range nodes {
+			if v == s {
+				continue
+			}
+			walk := randomWalk(v)
+			weight := 1.0
+			for step := 1; step < len(walk) && step < len(sourceWalk); step++ {
+				weight *= importance
+				if walk[step] == sourceWalk[step] {
+					totals[v] += weight
+					break
+				}
+			}
+		}
+	}
+
+	similarity := map[Node]float64{source: 1}
+	for v, total := range totals {
+		if total > 0 {
+			similarity[nodes[v]] = total / float64(numberOfWalks)
+		}
+	}
+	return similarity, nil
+}
+
+// SimilarityMeasure selects how the neighbourhoods of two nodes are compared.
+type SimilarityMeasure int
+
+const (
+	// CosineSimilarity is |N(u) ∩ N(v)| / sqrt(|N(u)|·|N(v)|).
+	CosineSimilarity SimilarityMeasure = iota
+	// JaccardSimilarity is |N(u) ∩ N(v)| / |N(u) ∪ N(v)|.
+	JaccardSimilarity
+)
+
+// NodeSimilarity is the similarity of a node to a reference node.
+type NodeSimilarity struct {
+	Node  Node
+	Score float64
+}
+
+/*
+AllPairsNeighbourhoodSimilarity returns, for every node, the nodes whose neighbourhoods are most similar to its own.
+
+Parameters:
+- g: The undirected graph.
+- measure: CosineSimilarity or JaccardSimilarity.
+- k: The number of similar nodes kept per node; zero or negative keeps all of them.
+
+Returns:
+- similar: For every node, the other nodes with a positive score sorted by decreasing score, ties broken by increasing node.
+
+Description:
+Only nodes sharing at least one neighbour have a positive score, so the
+function only compares nodes two hops apart instead of all V² pairs. Self-loops
+and parallel edges are ignored.
+
+Example:
+
+	similar := AllPairsNeighbourhoodSimilarity(StarGraph(4), JaccardSimilarity, 1)
+	fmt.Println(similar[1]) // Output: [{2 1}]
+*/
+func AllPairsNeighbourhoodSimilarity(g *UndirectedGraph, measure SimilarityMeasure, k int) map[Node][]NodeSimilarity {
+	neighbours := neighbourSets(g)
+	similar := make(map[Node][]NodeSimilarity, len(g.Nodes))
+	for _, node := range sortedNodes(g.Nodes) {
+		common := make(map[Node]int)
+		for middle := range neighbours[node] {
+			for other := range neighbours[middle] {
+				if other != node {
+					common[other]++
+				}
+			}
+		}
+
+		scores := make([]NodeSimilarity, 0, len(common))
+		for other, count := range common {
+			var score float64
+			switch measure {
+			case JaccardSimilarity:
+				score = float64(count) / float64(len(neighbours[node])+len(neighbours[other])-count)
+			default:
+				score = float64(count) / math.Sqrt(float64(len(neighbours[node])*len(neighbours[other])))
+			}
+			scores = append(scores, NodeSimilarity{Node: other, Score: score})
+		}
+		sort.Slice(scores, func(i, j int) bool {
+			if scores[i].Score != scores[j].Score {
+				return scores[i].Score > scores[j].Score
+			}
+			return scores[i].Node < scores[j].Node
+		})
+		if k > 0 && len(scores) > k {
+			scores = scores[:k]
+		}
+		similar[node] = scores
+	}
+	return similar
+}
+
+// neighbourhoodKey returns a canonical string for a set of nodes.
+func neighbourhoodKey(nodes map[Node]bool) string {
+	var builder strings.Builder
+	for _, node := range sortedNodes(nodes) {
+		fmt.Fprintf(&builder, "%d,", node)
+	}
+	return builder.String()
+}
+
+// groupClasses turns a class label per node into groups sorted by smallest node.
+func groupClasses[K comparable](labels map[Node]K) [][]Node {
+	groups := make(map[K][]Node)
+	for node, label := range labels {
+		groups[label] = append(groups[label], node)
+	}
+	classes := make([][]Node, 0, len(groups))
+	for _, group := range groups {
+		sort.Slice(group, func(i, j int) bool { return group[i] < group[j] })
+		classes = append(classes, group)
+	}
+	sort.Slice(classes, func(i, j int) bool { return classes[i][0] < classes[j][0] })
+	return classes
+}
+
+/*
+StructuralEquivalenceClasses groups nodes that have exactly the same neighbours.
+
+Parameters:
+- g: The undirected graph.
+
+Returns:
+- classes: The equivalence classes, each sorted, in increasing order of their smallest node.
+
+Description:
+Nodes u and v are structurally equivalent when N(u) \ {v} = N(v) \ {u}: they
+are either non-adjacent with identical neighbourhoods (false twins) or adjacent
+with identical closed neighbourhoods (true twins). Such nodes are
+interchangeable, which makes them candidate duplicates in entity resolution.
+Isolated nodes form a single class.
+*/
+func StructuralEquivalenceClasses(g *UndirectedGraph) [][]Node {
+	neighbours := neighbourSets(g)
+	nodes := sortedNodes(g.Nodes)
+	parent := make(map[Node]Node, len(nodes))
+	for _, node := range nodes {
+		parent[node] = node
+	}
+	var find func(node Node) Node
+	find = func(node Node) Node {
+		if parent[node] != node {
+			parent[node] = find(parent[node])
+		}
+		return parent[node]
+	}
+
+	// Nodes sharing an open (false twins) or closed (true twins) neighbourhood are merged
+	representatives := make(map[string]Node)
+	merge := func(key string, node Node) {
+		if representative, ok := representatives[key]; ok {
+			parent[find(node)] = find(representative)
+		} else {
+			representatives[key] = node
+		}
+	}
+	for _, node := range nodes {
+		closed := map[Node]bool{node: true}
+		for neighbor := range neighbours[node] {
+			closed[neighbor] = true
+		}
+		merge("open:"+neighbourhoodKey(neighbours[node]), node)
+		merge("closed:"+neighbourhoodKey(closed), node)
+	}
+
+	labels := make(map[Node]Node, len(nodes))
+	for _, node := range nodes {
+		labels[node] = find(node)
+	}
+	return groupClasses(labels)
+}
+
+/*
+RegularEquivalenceClasses groups nodes that play the same role: equivalent nodes have neighbours in the same classes.
+
+Parameters:
+- g: The undirected graph.
+- initial: An initial class per node, e.g. an attribute or a known role; if nil, nodes are initially grouped by degree.
+
+Returns:
+- classes: The coarsest regular equivalence refining the initial partition, each class sorted, in increasing order of their smallest node.
+
+Description:
+The partition is refined by splitting classes according to the set of classes
+found among the neighbours of each node, until it no longer changes (the
+CATREGE algorithm). Unlike structural equivalence, two regularly equivalent
+nodes need not share any neighbour: the managers of two different teams are
+regularly equivalent. Without an initial partition every connected graph
+without isolated nodes would form a single class, hence the degree default.
+*/
+func RegularEquivalenceClasses(g *UndirectedGraph, initial map[Node]int) [][]Node {
+	neighbours := neighbourSets(g)
+	nodes := sortedNodes(g.Nodes)
+
+	// Normalise the initial labels into consecutive class numbers
+	classOf := make(map[Node]int, len(nodes))
+	labelIdx := make(map[int]int)
+	for _, node := range nodes {
+		label := len(neighbours[node])
+		if initial != nil {
+			label = initial[node]
+		}
+		if _, ok := labelIdx[label]; !ok {
+			labelIdx[label] = len(labelIdx)
+		}
+		classOf[node] = labelIdx[label]
+	}
+	numberOfClasses := len(labelIdx)
+
+	for {
+		signatureIdx := make(map[string]int)
+		refined := make(map[Node]int, len(nodes))
+		for _, node := range nodes {
+			neighbourClasses := make(map[Node]bool)
+			for neighbor := range neighbours[node] {
+				neighbourClasses[Node(classOf[neighbor])] = true
+			}
+			signature := fmt.Sprintf("%d|%s", classOf[node], neighbourhoodKey(neighbourClasses))
+			if _, ok := signatureIdx[signature]; !ok {
+				signatureIdx[signature] = len(signatureIdx)
+			}
+			refined[node] = signatureIdx[signature]
+		}
+		classOf = refined
+		if len(signatureIdx) == numberOfClasses {
+			break
+		}
+		numberOfClasses = len(signatureIdx)
+	}
+	return groupClasses(classOf)
+}
@@ -0,0 +1,152 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestSimRank(t *testing.T) {
+	similarity, err := SimRank(StarGraph(4), 0.8, 100, 1e-9)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if math.Abs(similarity[1][2]-0.8) > 1e-9 || similarity[0][0] != 1 || similarity[0][1] != 0 {
+		t.Errorf("Unexpected star similarities %v", similarity)
+	}
+
+	// In a 4-cycle opposite nodes share both neighbours, which are themselves
+	// opposite: s = C/4·(2 + 2s), i.e. s = 0.3 / 0.7 for C = 0.6
+	similarity, _ = SimRank(CycleGraph(4), 0.6, 100, 1e-12)
+	if math.Abs(similarity[0][2]-3.0/7) > 1e-9 || math.Abs(similarity[1][3]-3.0/7) > 1e-9 || similarity[0][1] != 0 {
+		t.Errorf("Unexpected cycle similarities %v", similarity)
+	}
+	for u, row := range similarity {
+		for v, score := range row {
+			if math.Abs(score-similarity[v][u]) > 1e-12 {
+				t.Errorf("Similarity is not symmetric for %d and %d", u, v)
+			}
+		}
+	}
+
+	if _, err := SimRank(StarGraph(4), 1, 10, 0); err == nil {
+		t.Errorf("Expected an error for an importance factor of 1")
+	}
+}
+
+func TestMonteCarloSimRank(t *testing.T) {
+	// Walks from two leaves of a star always meet at the centre after one step
+	similarity, err := MonteCarloSimRank(StarGraph(5), 1, 0.8, 50, 10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := map[Node]float64{1: 1, 2: 0.8, 3: 0.8, 4: 0.8}
+	if len(similarity) != len(expected) {
+		t.Fatalf("Expected %v, but got %v", expected, similarity)
+	}
+	for node, score := range expected {
+		if math.Abs(similarity[node]-score) > 1e-9 {
+			t.Errorf("Expected %v for node %d, but got %v", score, node, similarity[node])
+		}
+	}
+
+	exact, _ := SimRank(CycleGraph(6), 0.8, 100, 1e-9)
+	estimate, _ := MonteCarloSimRank(CycleGraph(6), 0, 0.8, 20000, 30)
+	for node := Node(1); node < 6; node++ {
+		if math.Abs(exact[0][node]-estimate[node]) > 0.03 {
+			t.Errorf("Estimate %v for node %d is far from %v", estimate[node], node, exact[0][node])
+		}
+	}
+
+	if _, err := MonteCarloSimRank(StarGraph(5), 9, 0.8, 10, 10); err == nil {
+		t.Errorf("Expected an error for a missing source")
+	}
+}
+
+func TestAllPairsNeighbourhoodSimilarity(t *testing.T) {
+	// 0 and 1 share neighbours 2 and 3; 1 also links to 4
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 2}, {0, 3}, {1, 2}, {1, 3}, {1, 4}})
+
+	testCases := []struct {
+		name     string
+		measure  SimilarityMeasure
+		k        int
+		expected string
+	}{
+		{name: "Cosine", measure: CosineSimilarity, k: 0, expected: fmt.Sprint([]NodeSimilarity{{Node: 1, Score: 2 / math.Sqrt(6)}})},
+		{name: "Jaccard", measure: JaccardSimilarity, k: 0, expected: fmt.Sprint([]NodeSimilarity{{Node: 1, Score: 2.0 / 3}})},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			similar := AllPairsNeighbourhoodSimilarity(g, tc.measure, tc.k)
+			if fmt.Sprint(similar[0]) != tc.expected {
+				t.Errorf("Expected %v, but got %v", tc.expected, similar[0])
+			}
+		})
+	}
+
+	similar := AllPairsNeighbourhoodSimilarity(g, JaccardSimilarity, 1)
+	if len(similar[2]) != 1 || similar[2][0] != (NodeSimilarity{Node: 3, Score: 1}) {
+		t.Errorf("Expected node 3 to be the single most similar node to 2, but got %v", similar[2])
+	}
+	if len(similar[4]) != 1 {
+		t.Errorf("Expected top-1 pruning, but got %v", similar[4])
+	}
+}
+
+func TestStructuralEquivalenceClasses(t *testing.T) {
+	withIsolated := StarGraph(4)
+	withIsolated.AddNodes([]Node{7, 8})
+
+	testCases := []struct {
+		name     string
+		graph    *UndirectedGraph
+		expected string
+	}{
+		{name: "Star graph", graph: StarGraph(4), expected: "[[0] [1 2 3]]"},
+		{name: "Complete graph", graph: CompleteGraph(4), expected: "[[0 1 2 3]]"},
+		{name: "Path graph", graph: PathGraph(4), expected: "[[0] [1] [2] [3]]"},
+		{name: "Lollipop graph", graph: LollipopGraph(4, 1), expected: "[[0 1 2] [3] [4]]"},
+		{name: "Isolated nodes", graph: withIsolated, expected: "[[0] [1 2 3] [7 8]]"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if classes := fmt.Sprint(StructuralEquivalenceClasses(tc.graph)); classes != tc.expected {
+				t.Errorf("Expected %v, but got %v", tc.expected, classes)
+			}
+		})
+	}
+}
+
+func TestRegularEquivalenceClasses(t *testing.T) {
+	// Two managers 0 and 1 with their own teams
+	teams := &UndirectedGraph{}
+	teams.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 4}, {1, 5}})
+
+	testCases := []struct {
+		name     string
+		graph    *UndirectedGraph
+		initial  map[Node]int
+		expected string
+	}{
+		{name: "Path graph", graph: PathGraph(5), expected: "[[0 4] [1 3] [2]]"},
+		{name: "Teams", graph: teams, expected: "[[0 1] [2 3 4 5]]"},
+		{name: "Cycle graph", graph: CycleGraph(6), expected: "[[0 1 2 3 4 5]]"},
+		{
+			name:     "Cycle graph with roles",
+			graph:    CycleGraph(6),
+			initial:  map[Node]int{0: 1},
+			expected: "[[0] [1 5] [2 4] [3]]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if classes := fmt.Sprint(RegularEquivalenceClasses(tc.graph, tc.initial)); classes != tc.expected {
+				t.Errorf("Expected %v, but got %v", tc.expected, classes)
+			}
+		})
+	}
+}
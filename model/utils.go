@@ -1,5 +1,7 @@
 package model
 
+import "sort"
+
 type WeightedElement struct {
 	Payload any
 	Weight  float32
@@ -49,3 +51,11 @@ func GetDictKeys(dict map[Node]bool) []Node {
 	}
 	return keys
 }
+
+// sortedNodes returns the keys of a node set in increasing order, so that
+// algorithms iterating over a graph visit nodes deterministically.
+func sortedNodes(nodes map[Node]bool) []Node {
+	keys := GetDictKeys(nodes)
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}